@@ -0,0 +1,93 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Activity is struct for activity stream payload
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/activity_stream#json-format
+type Activity struct {
+	ID        int64                  `json:"id"`
+	URL       string                 `json:"url"`
+	Title     string                 `json:"title"`
+	Verb      string                 `json:"verb"`
+	UserID    int64                  `json:"user_id"`
+	ActorID   int64                  `json:"actor_id"`
+	User      User                   `json:"user"`
+	Actor     User                   `json:"actor"`
+	Object    map[string]interface{} `json:"object"`
+	Target    map[string]interface{} `json:"target"`
+	CreatedAt time.Time              `json:"created_at"`
+	UpdatedAt time.Time              `json:"updated_at"`
+}
+
+// ActivityListOptions is options for GetActivities
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/activity_stream#list-activities
+type ActivityListOptions struct {
+	PageOptions
+
+	// Since returns activities created after the time
+	Since *time.Time `url:"since,omitempty"`
+}
+
+// ActivityAPI an interface containing all activity stream related methods
+type ActivityAPI interface {
+	GetActivities(ctx context.Context, opts *ActivityListOptions) ([]Activity, Page, error)
+	GetActivity(ctx context.Context, id int64) (Activity, error)
+}
+
+// GetActivities fetches activity list of the current user
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/activity_stream#list-activities
+func (z *Client) GetActivities(ctx context.Context, opts *ActivityListOptions) ([]Activity, Page, error) {
+	var data struct {
+		Activities []Activity `json:"activities"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ActivityListOptions{}
+	}
+
+	u, err := addOptions("/activities.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Activities, data.Page, nil
+}
+
+// GetActivity gets a specified activity
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/activity_stream#show-activity
+func (z *Client) GetActivity(ctx context.Context, id int64) (Activity, error) {
+	var result struct {
+		Activity Activity `json:"activity"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/activities/%d.json", id))
+	if err != nil {
+		return Activity{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Activity{}, err
+	}
+	return result.Activity, nil
+}
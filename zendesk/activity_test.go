@@ -0,0 +1,53 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestGetActivities(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if since := r.URL.Query().Get("since"); since != "2019-03-01T00:00:00Z" {
+			t.Fatalf(`since param expect "2019-03-01T00:00:00Z", but got "%s"`, since)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "activities.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	since := time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)
+	activities, _, err := client.GetActivities(ctx, &ActivityListOptions{Since: &since})
+	if err != nil {
+		t.Fatalf("Failed to get activities: %s", err)
+	}
+
+	if len(activities) != 1 {
+		t.Fatalf("expected length of activities is 1, but got %d", len(activities))
+	}
+
+	activity := activities[0]
+	if activity.Actor.ID != 23546 || activity.Actor.Name != "John Hopeful" {
+		t.Fatalf("Activity actor was not parsed as expected: %v", activity.Actor)
+	}
+	if _, ok := activity.Object["ticket"]; !ok {
+		t.Fatalf("Activity object does not contain ticket: %v", activity.Object)
+	}
+}
+
+func TestGetActivity(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "activity.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	activity, err := client.GetActivity(ctx, 35)
+	if err != nil {
+		t.Fatalf("Failed to get activity: %s", err)
+	}
+
+	if activity.ID != 35 || activity.Verb != "tickets.assignment" {
+		t.Fatalf("Activity was not parsed as expected: %v", activity)
+	}
+}
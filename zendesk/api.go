@@ -5,22 +5,50 @@ package zendesk
 
 // API an interface containing all of the zendesk client methods
 type API interface {
+	ActivityAPI
+	ArticleAPI
 	AutomationAPI
 	AttachmentAPI
+	AuditLogAPI
 	BrandAPI
+	BusinessRulesAPI
+	CollaboratorAPI
+	CustomStatusAPI
+	DeletedTicketAPI
 	DynamicContentAPI
 	GroupAPI
+	GroupMembershipAPI
+	JobStatusAPI
 	LocaleAPI
+	MacroAPI
+	OAuthAPI
+	ProblemAPI
+	RawAPI
+	RequestAPI
 	TicketAPI
+	TicketAuditAPI
+	TicketCommentAPI
+	TicketUserAPI
 	TicketFieldAPI
 	TicketFormAPI
+	TicketMetricEventAPI
 	TriggerAPI
+	TriggerCategoryAPI
 	TargetAPI
 	UserAPI
 	UserFieldAPI
 	OrganizationAPI
+	NPSAPI
+	SatisfactionRatingAPI
+	SatisfactionReasonAPI
+	ScheduleAPI
 	SearchAPI
+	SharingAgreementAPI
+	SideConversationAPI
 	SLAPolicyAPI
+	SuspendedTicketAPI
+	TalkAPI
+	ViewAPI
 }
 
 var _ API = (*Client)(nil)
@@ -0,0 +1,33 @@
+package zendesk
+
+import "fmt"
+
+// apiBase is a base path of a Zendesk product API relative to the client's base URL.
+// Resources declare which product they belong to, and build their paths with it
+type apiBase string
+
+const (
+	supportAPI    apiBase = ""
+	helpCenterAPI apiBase = "/help_center"
+	chatAPI       apiBase = "/chat"
+	talkAPI       apiBase = "/channels/voice"
+)
+
+// path joins p to the base path
+func (b apiBase) path(p string) string {
+	return string(b) + p
+}
+
+// locale scopes the base path by locale. e.g. /help_center/en-us
+// An empty locale returns the base as is
+func (b apiBase) locale(locale string) apiBase {
+	if locale == "" {
+		return b
+	}
+	return apiBase(fmt.Sprintf("%s/%s", b, locale))
+}
+
+// addOptions joins p to the base path and adds query options to it
+func (b apiBase) addOptions(p string, opts interface{}) (string, error) {
+	return addOptions(b.path(p), opts)
+}
@@ -0,0 +1,59 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestAPIBasePath(t *testing.T) {
+	cases := []struct {
+		base     apiBase
+		path     string
+		expected string
+	}{
+		{supportAPI, "/tickets.json", "/tickets.json"},
+		{helpCenterAPI, "/articles.json", "/help_center/articles.json"},
+		{helpCenterAPI.locale("en-us"), "/articles.json", "/help_center/en-us/articles.json"},
+		{helpCenterAPI.locale(""), "/articles.json", "/help_center/articles.json"},
+		{talkAPI, "/stats/agents_activity.json", "/channels/voice/stats/agents_activity.json"},
+		{chatAPI, "/chats", "/chat/chats"},
+	}
+
+	for _, c := range cases {
+		if actual := c.base.path(c.path); actual != c.expected {
+			t.Fatalf("path expect %s, but got %s", c.expected, actual)
+		}
+	}
+}
+
+func TestAPIBaseResolvesAbsoluteURL(t *testing.T) {
+	client, _ := NewClient(&http.Client{})
+	if err := client.SetSubdomain("example"); err != nil {
+		t.Fatalf("Failed to set subdomain: %s", err)
+	}
+
+	u, err := helpCenterAPI.locale("en-us").addOptions("/articles.json", &ArticleListOptions{SortBy: "position"})
+	if err != nil {
+		t.Fatalf("Failed to build path: %s", err)
+	}
+
+	expected := "https://example.zendesk.com/api/v2/help_center/en-us/articles.json?sort_by=position"
+	if actual := client.resolve(u); actual != expected {
+		t.Fatalf("URL expect %s, but got %s", expected, actual)
+	}
+}
+
+func TestIncludeBuilderHonorsAPIBase(t *testing.T) {
+	builder := includeBuilder{base: helpCenterAPI}
+	builder.addKey("users")
+
+	u, err := builder.path("/articles.json")
+	if err != nil {
+		t.Fatalf("Failed to build path: %s", err)
+	}
+
+	expected := "/help_center/articles.json?include=users"
+	if u != expected {
+		t.Fatalf("path expect %s, but got %s", expected, u)
+	}
+}
@@ -0,0 +1,239 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/tylerconlee/zendesk-go/zendesk/sideload"
+)
+
+// Article is struct for help center article payload
+//
+// ref: https://developer.zendesk.com/rest_api/docs/help_center/articles#json-format
+type Article struct {
+	ID                int64      `json:"id,omitempty"`
+	URL               string     `json:"url,omitempty"`
+	HTMLURL           string     `json:"html_url,omitempty"`
+	Title             string     `json:"title,omitempty"`
+	Body              string     `json:"body,omitempty"`
+	Locale            string     `json:"locale,omitempty"`
+	SectionID         int64      `json:"section_id,omitempty"`
+	AuthorID          int64      `json:"author_id,omitempty"`
+	PermissionGroupID int64      `json:"permission_group_id,omitempty"`
+	UserSegmentID     *int64     `json:"user_segment_id,omitempty"`
+	Draft             bool       `json:"draft,omitempty"`
+	Promoted          bool       `json:"promoted,omitempty"`
+	Outdated          bool       `json:"outdated,omitempty"`
+	CommentsDisabled  bool       `json:"comments_disabled,omitempty"`
+	Position          int64      `json:"position,omitempty"`
+	LabelNames        []string   `json:"label_names,omitempty"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time `json:"updated_at,omitempty"`
+}
+
+// ArticleListOptions is options for GetArticles
+//
+// ref: https://developer.zendesk.com/rest_api/docs/help_center/articles#list-articles
+type ArticleListOptions struct {
+	PageOptions
+
+	// Locale restricts articles to the locale. It is a part of the path
+	Locale string `url:"-"`
+
+	// LabelNames is comma separated list of label names
+	LabelNames string `url:"label_names,omitempty"`
+
+	// SortBy can take "position", "title", "created_at" or "updated_at"
+	SortBy string `url:"sort_by,omitempty"`
+
+	// SortOrder can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// ArticleSearchOptions is options for SearchArticles
+//
+// ref: https://developer.zendesk.com/rest_api/docs/help_center/search#search-articles
+type ArticleSearchOptions struct {
+	PageOptions
+
+	Locale     string `url:"locale,omitempty"`
+	LabelNames string `url:"label_names,omitempty"`
+	Category   int64  `url:"category,omitempty"`
+	Section    int64  `url:"section,omitempty"`
+}
+
+// ArticleAPI an interface containing all help center article related methods
+type ArticleAPI interface {
+	GetArticles(ctx context.Context, opts *ArticleListOptions) ([]Article, Page, error)
+	GetArticle(ctx context.Context, id int64, sideLoad ...sideload.SideLoader) (Article, error)
+	CreateArticle(ctx context.Context, sectionID int64, article Article) (Article, error)
+	UpdateArticle(ctx context.Context, id int64, article Article) (Article, error)
+	DeleteArticle(ctx context.Context, id int64) error
+	SearchArticles(ctx context.Context, query string, opts *ArticleSearchOptions) ([]Article, Page, error)
+}
+
+// GetArticles fetches article list
+//
+// ref: https://developer.zendesk.com/rest_api/docs/help_center/articles#list-articles
+func (z *Client) GetArticles(ctx context.Context, opts *ArticleListOptions) ([]Article, Page, error) {
+	var data struct {
+		Articles []Article `json:"articles"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ArticleListOptions{}
+	}
+
+	u, err := helpCenterAPI.locale(tmp.Locale).addOptions("/articles.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Articles, data.Page, nil
+}
+
+// GetArticle gets a specified article.
+// Sideloaders such as sideload.Users load the related records with the same request
+//
+// ref: https://developer.zendesk.com/rest_api/docs/help_center/articles#show-article
+func (z *Client) GetArticle(ctx context.Context, id int64, sideLoad ...sideload.SideLoader) (Article, error) {
+	var result struct {
+		Article Article `json:"article"`
+	}
+
+	u := helpCenterAPI.path(fmt.Sprintf("/articles/%d.json", id))
+	if len(sideLoad) > 0 {
+		builder := includeBuilder{base: helpCenterAPI}
+		for _, v := range sideLoad {
+			builder.addKey(v.Key())
+		}
+
+		var err error
+		u, err = builder.path(fmt.Sprintf("/articles/%d.json", id))
+		if err != nil {
+			return Article{}, err
+		}
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return Article{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Article{}, err
+	}
+
+	err = unmarshalSideLoads(body, sideLoad)
+	if err != nil {
+		return Article{}, err
+	}
+	return result.Article, nil
+}
+
+// CreateArticle creates a new article in the section
+//
+// ref: https://developer.zendesk.com/rest_api/docs/help_center/articles#create-article
+func (z *Client) CreateArticle(ctx context.Context, sectionID int64, article Article) (Article, error) {
+	var data, result struct {
+		Article Article `json:"article"`
+	}
+	data.Article = article
+
+	body, err := z.post(ctx, helpCenterAPI.locale(article.Locale).path(fmt.Sprintf("/sections/%d/articles.json", sectionID)), data)
+	if err != nil {
+		return Article{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Article{}, err
+	}
+	return result.Article, nil
+}
+
+// UpdateArticle updates metadata of a specified article
+//
+// ref: https://developer.zendesk.com/rest_api/docs/help_center/articles#update-article
+func (z *Client) UpdateArticle(ctx context.Context, id int64, article Article) (Article, error) {
+	var data, result struct {
+		Article Article `json:"article"`
+	}
+	data.Article = article
+
+	body, err := z.put(ctx, helpCenterAPI.path(fmt.Sprintf("/articles/%d.json", id)), data)
+	if err != nil {
+		return Article{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Article{}, err
+	}
+	return result.Article, nil
+}
+
+// DeleteArticle archives a specified article
+//
+// ref: https://developer.zendesk.com/rest_api/docs/help_center/articles#archive-article
+func (z *Client) DeleteArticle(ctx context.Context, id int64) error {
+	err := z.delete(ctx, helpCenterAPI.path(fmt.Sprintf("/articles/%d.json", id)))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SearchArticles searches articles by query
+//
+// ref: https://developer.zendesk.com/rest_api/docs/help_center/search#search-articles
+func (z *Client) SearchArticles(ctx context.Context, query string, opts *ArticleSearchOptions) ([]Article, Page, error) {
+	var data struct {
+		Results []Article `json:"results"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ArticleSearchOptions{}
+	}
+
+	req := struct {
+		ArticleSearchOptions
+		Query string `url:"query"`
+	}{
+		ArticleSearchOptions: *tmp,
+		Query:                query,
+	}
+
+	u, err := helpCenterAPI.addOptions("/articles/search.json", req)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Results, data.Page, nil
+}
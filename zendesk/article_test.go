@@ -0,0 +1,161 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/tylerconlee/zendesk-go/zendesk/sideload"
+)
+
+func TestGetArticles(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/en-us/articles.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("label_names") != "knowledge" {
+			t.Fatalf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "articles.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	articles, _, err := client.GetArticles(ctx, &ArticleListOptions{
+		Locale:     "en-us",
+		LabelNames: "knowledge",
+	})
+	if err != nil {
+		t.Fatalf("Failed to get articles: %s", err)
+	}
+
+	if len(articles) != 2 {
+		t.Fatalf("expected length of articles is 2, but got %d", len(articles))
+	}
+	if len(articles[0].LabelNames) != 2 || articles[0].LabelNames[0] != "knowledge" {
+		t.Fatalf("Article labels were not parsed as expected: %v", articles[0].LabelNames)
+	}
+	if articles[1].UserSegmentID == nil || *articles[1].UserSegmentID != 360000371671 {
+		t.Fatalf("Article user segment was not parsed as expected: %v", articles[1].UserSegmentID)
+	}
+}
+
+func TestGetArticle(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "article.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	article, err := client.GetArticle(ctx, 360033862132)
+	if err != nil {
+		t.Fatalf("Failed to get article: %s", err)
+	}
+
+	expectedID := int64(360033862132)
+	if article.ID != expectedID {
+		t.Fatalf("Returned article does not have the expected ID %d. Article id is %d", expectedID, article.ID)
+	}
+}
+
+func TestGetArticleSideloaded(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/help_center/articles/1.json" || r.URL.Query().Get("include") != "users" {
+			t.Fatalf("Unexpected request %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"article":{"id":1},"users":[{"id":10}]}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var users []User
+	article, err := client.GetArticle(ctx, 1, sideload.Users(&users))
+	if err != nil {
+		t.Fatalf("Failed to get article: %s", err)
+	}
+
+	if article.ID != 1 {
+		t.Fatalf("Returned article does not have the expected ID 1. Article id is %d", article.ID)
+	}
+	if len(users) != 1 || users[0].ID != 10 {
+		t.Fatalf("Users were not sideloaded as expected: %v", users)
+	}
+}
+
+func TestCreateArticle(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/help_center/en-us/sections/360007194452/articles.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "article.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	article, err := client.CreateArticle(ctx, 360007194452, Article{
+		Title:             "How can agents leverage knowledge?",
+		Locale:            "en-us",
+		PermissionGroupID: 1909883,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create article: %s", err)
+	}
+
+	if article.SectionID != 360007194452 {
+		t.Fatalf("Created article does not have the expected section id. Section id is %d", article.SectionID)
+	}
+}
+
+func TestUpdateArticle(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "article.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	article, err := client.UpdateArticle(ctx, 360033862132, Article{Promoted: true})
+	if err != nil {
+		t.Fatalf("Failed to update article: %s", err)
+	}
+
+	if !article.Promoted {
+		t.Fatalf("Updated article is not promoted")
+	}
+}
+
+func TestDeleteArticle(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/help_center/articles/360033862132.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteArticle(ctx, 360033862132); err != nil {
+		t.Fatalf("Failed to delete article: %s", err)
+	}
+}
+
+func TestSearchArticles(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/help_center/articles/search.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		if q.Get("query") != "knowledge" || q.Get("locale") != "en-us" {
+			t.Fatalf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "article_search.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	articles, _, err := client.SearchArticles(ctx, "knowledge", &ArticleSearchOptions{Locale: "en-us"})
+	if err != nil {
+		t.Fatalf("Failed to search articles: %s", err)
+	}
+
+	if len(articles) != 1 || articles[0].Title != "How can agents leverage knowledge?" {
+		t.Fatalf("Articles were not returned as expected: %v", articles)
+	}
+}
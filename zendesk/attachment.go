@@ -3,10 +3,14 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
 	"sync"
 )
 
@@ -66,7 +70,7 @@ func (wr *writer) open() error {
 
 	wr.w = w
 	path := "/uploads.json"
-	req, err := http.NewRequest(http.MethodPost, wr.baseURL.String()+path, r)
+	req, err := http.NewRequestWithContext(wr.ctx, http.MethodPost, wr.resolve(path), r)
 	if err != nil {
 		return err
 	}
@@ -83,7 +87,7 @@ func (wr *writer) open() error {
 	req.URL.RawQuery = q.Encode()
 
 	go func() {
-		resp, err := wr.httpClient.Do(req)
+		resp, err := wr.do(req)
 		if err != nil {
 			wr.c <- result{
 				err: err,
@@ -158,6 +162,8 @@ type AttachmentAPI interface {
 	UploadAttachment(ctx context.Context, filename string, token string) UploadWriter
 	DeleteUpload(ctx context.Context, token string) error
 	GetAttachment(ctx context.Context, id int64) (Attachment, error)
+	DownloadAttachment(ctx context.Context, a Attachment, w io.Writer) error
+	DownloadTicketAttachments(ctx context.Context, ticketID int64, dir string) ([]string, error)
 }
 
 // UploadAttachment returns a writer that can be used to create a zendesk attachment
@@ -196,3 +202,116 @@ func (z *Client) GetAttachment(ctx context.Context, id int64) (Attachment, error
 
 	return result.Attachment, nil
 }
+
+// maxAttachmentRedirects is the max number of redirects followed by DownloadAttachment
+const maxAttachmentRedirects = 10
+
+// DownloadAttachment writes the content of the attachment to w.
+// Zendesk redirects content_url to the storage host. Credentials are sent
+// only to the API host, and never to the host redirected to
+func (z *Client) DownloadAttachment(ctx context.Context, a Attachment, w io.Writer) error {
+	if a.ContentURL == "" {
+		return fmt.Errorf("attachment %d has no content_url", a.ID)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.ContentURL, nil)
+	if err != nil {
+		return err
+	}
+
+	apiHost := ""
+	if z.baseURL != nil {
+		apiHost = z.baseURL.Host
+	}
+	req = z.prepareRequest(ctx, req)
+	if req.URL.Host != apiHost {
+		req.Header.Del("Authorization")
+	}
+
+	// Copy the client so that the redirect policy applies only to this download
+	httpClient := *z.httpClient
+	httpClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) >= maxAttachmentRedirects {
+			return errors.New("stopped after too many redirects")
+		}
+		if req.URL.Host != apiHost {
+			req.Header.Del("Authorization")
+		}
+		return nil
+	}
+	c := *z
+	c.httpClient = &httpClient
+
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		return Error{
+			body: body,
+			resp: resp,
+		}
+	}
+
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// DownloadTicketAttachments saves the attachments of every comment on the ticket,
+// following all pages of comments, into dir and returns the paths of the saved files.
+// Files are named "<attachment id>_<file name>" so that attachments with the
+// same name don't overwrite each other
+func (z *Client) DownloadTicketAttachments(ctx context.Context, ticketID int64, dir string) ([]string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	err := z.EachTicketComment(ctx, ticketID, func(comment TicketComment) error {
+		for _, a := range comment.Attachments {
+			path := filepath.Join(dir, fmt.Sprintf("%d_%s", a.ID, attachmentFileName(a)))
+			if err := z.downloadAttachmentFile(ctx, a, path); err != nil {
+				return err
+			}
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	return paths, err
+}
+
+func (z *Client) downloadAttachmentFile(ctx context.Context, a Attachment, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	err = z.DownloadAttachment(ctx, a, f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(path)
+	}
+	return err
+}
+
+// attachmentFileName returns the file name of the attachment which is safe to join to a directory
+func attachmentFileName(a Attachment) string {
+	name := filepath.Base(filepath.Clean("/" + a.FileName))
+	if name == "/" || name == "." {
+		if u, err := url.Parse(a.ContentURL); err == nil {
+			name = filepath.Base(u.Path)
+		}
+	}
+	if name == "/" || name == "." || name == "" {
+		name = "attachment"
+	}
+	return name
+}
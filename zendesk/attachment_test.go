@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha1"
+	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"path/filepath"
 	"reflect"
 	"testing"
@@ -118,3 +121,122 @@ func TestGetAttachment(t *testing.T) {
 		t.Fatalf("Returned attachment does not have the expected ID %d. Attachment id is %d", expectedID, attachment.ID)
 	}
 }
+
+// newAttachmentMockAPIs returns an API server which redirects attachment content
+// to a storage server on another host, like Zendesk does
+func newAttachmentMockAPIs(t *testing.T) (api *httptest.Server, storage *httptest.Server) {
+	storage = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Fatalf("Authorization header must not be sent to the storage host, but got %s", auth)
+		}
+		w.Write([]byte("content of " + r.URL.Path))
+	}))
+
+	api = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			t.Fatalf("Authorization header must be sent to the API host")
+		}
+		switch r.URL.Path {
+		case "/tickets/2/comments.json":
+			fmt.Fprintf(w, `{"comments":[
+				{"id":1,"body":"logs","attachments":[{"id":498483,"file_name":"crash.log","content_url":"%[1]s/attachments/token/a/?name=crash.log"}]},
+				{"id":2,"body":"no attachment","attachments":[]},
+				{"id":3,"body":"screenshot","attachments":[{"id":498484,"file_name":"../screen.png","content_url":"%[1]s/attachments/token/b/?name=screen.png"}]}
+			]}`, "http://"+r.Host)
+		case "/tickets/3/comments.json":
+			if r.URL.Query().Get("page") == "2" {
+				fmt.Fprintf(w, `{"comments":[
+					{"id":5,"body":"logs","attachments":[{"id":498485,"file_name":"late.log","content_url":"%[1]s/attachments/token/c/?name=late.log"}]}
+				],"next_page":null}`, "http://"+r.Host)
+				return
+			}
+			fmt.Fprintf(w, `{"comments":[
+				{"id":4,"body":"no attachment","attachments":[]}
+			],"next_page":"%[1]s/tickets/3/comments.json?page=2"}`, "http://"+r.Host)
+		default:
+			http.Redirect(w, r, storage.URL+"/files"+r.URL.Path, http.StatusFound)
+		}
+	}))
+	return api, storage
+}
+
+func TestDownloadAttachmentStripsAuthOnRedirect(t *testing.T) {
+	api, storage := newAttachmentMockAPIs(t)
+	defer api.Close()
+	defer storage.Close()
+
+	client := newTestClient(api)
+	client.SetCredential(NewAPITokenCredential("john.doe@example.com", "apitoken"))
+
+	var buf bytes.Buffer
+	err := client.DownloadAttachment(ctx, Attachment{ID: 498483, ContentURL: api.URL + "/attachments/token/a/"}, &buf)
+	if err != nil {
+		t.Fatalf("Failed to download attachment: %s", err)
+	}
+
+	expected := "content of /files/attachments/token/a/"
+	if buf.String() != expected {
+		t.Fatalf("attachment content expect %q, but got %q", expected, buf.String())
+	}
+}
+
+func TestDownloadTicketAttachments(t *testing.T) {
+	api, storage := newAttachmentMockAPIs(t)
+	defer api.Close()
+	defer storage.Close()
+
+	dir, err := ioutil.TempDir("", "attachments")
+	if err != nil {
+		t.Fatalf("Failed to create directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	client := newTestClient(api)
+	client.SetCredential(NewAPITokenCredential("john.doe@example.com", "apitoken"))
+
+	paths, err := client.DownloadTicketAttachments(ctx, 2, dir)
+	if err != nil {
+		t.Fatalf("Failed to download ticket attachments: %s", err)
+	}
+
+	expected := []string{
+		filepath.Join(dir, "498483_crash.log"),
+		filepath.Join(dir, "498484_screen.png"),
+	}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Fatalf("saved files expect %v, but got %v", expected, paths)
+	}
+
+	b, err := ioutil.ReadFile(paths[1])
+	if err != nil {
+		t.Fatalf("Failed to read saved attachment: %s", err)
+	}
+	if string(b) != "content of /files/attachments/token/b/" {
+		t.Fatalf("saved attachment has unexpected content %q", string(b))
+	}
+}
+
+func TestDownloadTicketAttachmentsAcrossPages(t *testing.T) {
+	api, storage := newAttachmentMockAPIs(t)
+	defer api.Close()
+	defer storage.Close()
+
+	dir, err := ioutil.TempDir("", "attachments")
+	if err != nil {
+		t.Fatalf("Failed to create directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	client := newTestClient(api)
+	client.SetCredential(NewAPITokenCredential("john.doe@example.com", "apitoken"))
+
+	paths, err := client.DownloadTicketAttachments(ctx, 3, dir)
+	if err != nil {
+		t.Fatalf("Failed to download ticket attachments: %s", err)
+	}
+
+	expected := []string{filepath.Join(dir, "498485_late.log")}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Fatalf("saved files expect %v, but got %v", expected, paths)
+	}
+}
@@ -0,0 +1,112 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// AuditLog is struct for account audit log payload
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/audit_logs#json-format
+type AuditLog struct {
+	ID                int64     `json:"id"`
+	URL               string    `json:"url"`
+	ActorID           int64     `json:"actor_id"`
+	SourceID          int64     `json:"source_id"`
+	SourceType        string    `json:"source_type"`
+	SourceLabel       string    `json:"source_label"`
+	Action            string    `json:"action"`
+	ChangeDescription string    `json:"change_description"`
+	IPAddress         string    `json:"ip_address"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// AuditLogListOptions is options for GetAuditLogs
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/audit_logs#list-audit-logs
+type AuditLogListOptions struct {
+	PageOptions
+
+	// SourceType filters audit logs by source type, e.g. "user", "rule"
+	SourceType string `url:"filter[source_type],omitempty"`
+
+	// SourceID filters audit logs by source id. It requires SourceType
+	SourceID int64 `url:"filter[source_id],omitempty"`
+
+	// ActorID filters audit logs by the user who made the change
+	ActorID int64 `url:"filter[actor_id],omitempty"`
+
+	// IPAddress filters audit logs by IP address
+	IPAddress string `url:"filter[ip_address],omitempty"`
+
+	// Action can take "create", "destroy", "exported", "login" or "update"
+	Action string `url:"filter[action],omitempty"`
+
+	// CreatedAt filters audit logs by time range. It takes start and end time
+	CreatedAt []time.Time `url:"filter[created_at][],omitempty"`
+
+	// SortBy can take "created_at"
+	SortBy string `url:"sort_by,omitempty"`
+
+	// SortOrder can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// AuditLogAPI an interface containing all audit log related methods
+type AuditLogAPI interface {
+	GetAuditLogs(ctx context.Context, opts *AuditLogListOptions) ([]AuditLog, Page, error)
+	GetAuditLog(ctx context.Context, id int64) (AuditLog, error)
+}
+
+// GetAuditLogs fetches account audit log list
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/audit_logs#list-audit-logs
+func (z *Client) GetAuditLogs(ctx context.Context, opts *AuditLogListOptions) ([]AuditLog, Page, error) {
+	var data struct {
+		AuditLogs []AuditLog `json:"audit_logs"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &AuditLogListOptions{}
+	}
+
+	u, err := addOptions("/audit_logs.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.AuditLogs, data.Page, nil
+}
+
+// GetAuditLog gets a specified audit log
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/audit_logs#show-audit-log
+func (z *Client) GetAuditLog(ctx context.Context, id int64) (AuditLog, error) {
+	var result struct {
+		AuditLog AuditLog `json:"audit_log"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/audit_logs/%d.json", id))
+	if err != nil {
+		return AuditLog{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return AuditLog{}, err
+	}
+	return result.AuditLog, nil
+}
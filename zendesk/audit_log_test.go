@@ -0,0 +1,68 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestGetAuditLogs(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if v := q.Get("filter[source_type]"); v != "user" {
+			t.Fatalf(`filter[source_type] expect "user", but got "%s"`, v)
+		}
+		if v := q.Get("filter[actor_id]"); v != "1234" {
+			t.Fatalf(`filter[actor_id] expect "1234", but got "%s"`, v)
+		}
+		if v := q.Get("filter[action]"); v != "create" {
+			t.Fatalf(`filter[action] expect "create", but got "%s"`, v)
+		}
+		createdAt := q["filter[created_at][]"]
+		if len(createdAt) != 2 || createdAt[0] != "2019-03-01T00:00:00Z" || createdAt[1] != "2019-03-31T00:00:00Z" {
+			t.Fatalf("filter[created_at][] was not encoded as expected: %v", createdAt)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "audit_logs.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	logs, _, err := client.GetAuditLogs(ctx, &AuditLogListOptions{
+		SourceType: "user",
+		ActorID:    1234,
+		Action:     "create",
+		CreatedAt: []time.Time{
+			time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2019, 3, 31, 0, 0, 0, 0, time.UTC),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to get audit logs: %s", err)
+	}
+
+	if len(logs) != 1 {
+		t.Fatalf("expected length of audit logs is 1, but got %d", len(logs))
+	}
+
+	log := logs[0]
+	if log.Action != "create" || log.SourceID != 3456 || log.IPAddress != "209.119.38.228" {
+		t.Fatalf("Audit log was not parsed as expected: %v", log)
+	}
+}
+
+func TestGetAuditLog(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "audit_log.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	log, err := client.GetAuditLog(ctx, 498483)
+	if err != nil {
+		t.Fatalf("Failed to get audit log: %s", err)
+	}
+
+	if log.ID != 498483 {
+		t.Fatalf("Returned audit log does not have the expected ID 498483. Audit log id is %d", log.ID)
+	}
+}
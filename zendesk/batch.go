@@ -0,0 +1,91 @@
+package zendesk
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maxRateLimitRetries is the max number of retries of a rate limited request in batch helpers
+const maxRateLimitRetries = 3
+
+// GetTicketsConcurrent gets tickets by GetTicket with at most concurrency calls in flight.
+// A rate limited call is retried after the Retry-After duration.
+// Results and errors are keyed by ticket id
+func (z *Client) GetTicketsConcurrent(ctx context.Context, ids []int64, concurrency int) (map[int64]Ticket, map[int64]error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		tickets = make(map[int64]Ticket, len(ids))
+		errs    = make(map[int64]error)
+		jobs    = make(chan int64)
+	)
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				var ticket Ticket
+				err := retryRateLimited(ctx, func() error {
+					var err error
+					ticket, err = z.GetTicket(ctx, id)
+					return err
+				})
+
+				mu.Lock()
+				if err != nil {
+					errs[id] = err
+				} else {
+					tickets[id] = ticket
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, id := range ids {
+		jobs <- id
+	}
+	close(jobs)
+	wg.Wait()
+
+	return tickets, errs
+}
+
+// retryRateLimited calls fn and retries it while it fails with 429 Too Many Requests
+func retryRateLimited(ctx context.Context, fn func() error) error {
+	for i := 0; ; i++ {
+		err := fn()
+		wait, ok := retryAfter(err)
+		if !ok || i >= maxRateLimitRetries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// retryAfter returns the duration to wait if err is a rate limit error
+func retryAfter(err error) (time.Duration, bool) {
+	zerr, ok := err.(Error)
+	if !ok || zerr.Status() != http.StatusTooManyRequests {
+		return 0, false
+	}
+
+	sec, err := strconv.Atoi(zerr.Headers().Get("Retry-After"))
+	if err != nil {
+		return time.Second, true
+	}
+	return time.Duration(sec) * time.Second, true
+}
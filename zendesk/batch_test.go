@@ -0,0 +1,73 @@
+package zendesk
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetTicketsConcurrent(t *testing.T) {
+	var inFlight, maxInFlight int32
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/tickets/"), ".json")
+		if id == "5" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprintf(w, `{"ticket":{"id":%s}}`, id)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ids := []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	tickets, errs := client.GetTicketsConcurrent(ctx, ids, 3)
+
+	if max := atomic.LoadInt32(&maxInFlight); max > 3 {
+		t.Fatalf("expected concurrency is capped at 3, but got %d", max)
+	}
+	if len(tickets) != 9 {
+		t.Fatalf("expected length of tickets is 9, but got %d", len(tickets))
+	}
+	if tickets[7].ID != 7 {
+		t.Fatalf("Ticket is not keyed by id: %v", tickets[7])
+	}
+	if len(errs) != 1 || errs[5] == nil {
+		t.Fatalf("Error was not reported for id 5: %v", errs)
+	}
+}
+
+func TestGetTicketsConcurrentRetriesRateLimited(t *testing.T) {
+	var requests int32
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"ticket":{"id":1}}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, errs := client.GetTicketsConcurrent(ctx, []int64{1}, 1)
+	if len(errs) != 0 {
+		t.Fatalf("Rate limited request was not retried: %v", errs)
+	}
+	if tickets[1].ID != 1 {
+		t.Fatalf("Ticket was not returned after retry: %v", tickets)
+	}
+}
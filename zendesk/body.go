@@ -0,0 +1,111 @@
+package zendesk
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// ErrResponseTooLarge is returned when a response body exceeds the size set by SetMaxResponseSize
+var ErrResponseTooLarge = errors.New("response body exceeds the max response size")
+
+// SetMaxResponseSize sets the max size of a response body in bytes.
+// Reading a larger body fails with ErrResponseTooLarge. Zero means no limit
+func (z *Client) SetMaxResponseSize(n int64) {
+	z.maxBodySize = n
+}
+
+// maxBytesReader reads up to n bytes from r and fails with ErrResponseTooLarge after that
+type maxBytesReader struct {
+	io.ReadCloser
+	n int64
+}
+
+func (m *maxBytesReader) Read(p []byte) (int, error) {
+	if m.n <= 0 {
+		var b [1]byte
+		n, err := m.ReadCloser.Read(b[:])
+		if n > 0 {
+			return 0, ErrResponseTooLarge
+		}
+		return 0, err
+	}
+
+	if int64(len(p)) > m.n {
+		p = p[:m.n]
+	}
+	n, err := m.ReadCloser.Read(p)
+	m.n -= int64(n)
+	return n, err
+}
+
+// getJSON is like get, but decodes the response body into v while reading it
+// instead of buffering the whole body first
+func (z *Client) getJSON(ctx context.Context, path string, v interface{}, opts ...RequestOption) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, z.resolve(path), nil)
+	if err != nil {
+		return err
+	}
+
+	req = z.prepareRequest(ctx, req, opts...)
+
+	resp, err := z.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		return Error{
+			body: body,
+			resp: resp,
+		}
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// gzipReadCloser closes both of the gzip reader and the underlying body
+type gzipReadCloser struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (g *gzipReadCloser) Close() error {
+	g.Reader.Close()
+	return g.body.Close()
+}
+
+// decodeContentEncoding replaces the body of gzip encoded response with decompressed one.
+// Go decompresses transparently only when the transport itself requested gzip,
+// so it is done explicitly here to work with any transport
+func decodeContentEncoding(resp *http.Response) error {
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return nil
+	}
+
+	zr, err := gzip.NewReader(resp.Body)
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		resp.Body.Close()
+		return err
+	}
+
+	resp.Body = &gzipReadCloser{Reader: zr, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
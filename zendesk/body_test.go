@@ -0,0 +1,135 @@
+package zendesk
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestGetJSONMatchesGet(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "tickets.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var streamed, buffered struct {
+		Tickets []Ticket `json:"tickets"`
+		Page
+	}
+
+	if err := client.getJSON(ctx, "/tickets.json", &streamed); err != nil {
+		t.Fatalf("Failed to decode streaming response: %s", err)
+	}
+
+	body, err := client.get(ctx, "/tickets.json")
+	if err != nil {
+		t.Fatalf("Failed to get response: %s", err)
+	}
+	if err := json.Unmarshal(body, &buffered); err != nil {
+		t.Fatalf("Failed to unmarshal response: %s", err)
+	}
+
+	if len(streamed.Tickets) == 0 || !reflect.DeepEqual(streamed, buffered) {
+		t.Fatalf("Streaming decode differs from buffered decode.\n%v\n%v", streamed, buffered)
+	}
+}
+
+func TestGetJSONReturnsError(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodGet, "tickets.json", http.StatusNotFound)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var v interface{}
+	err := client.getJSON(ctx, "/tickets.json", &v)
+	if zerr, ok := err.(Error); !ok || zerr.Status() != http.StatusNotFound {
+		t.Fatalf("expect Error with status 404, but got %v", err)
+	}
+}
+
+func TestMaxResponseSize(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "tickets.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.SetMaxResponseSize(64)
+
+	if _, _, err := client.GetTickets(ctx, nil); err != ErrResponseTooLarge {
+		t.Fatalf("expect ErrResponseTooLarge from buffered read, but got %v", err)
+	}
+
+	var v interface{}
+	if err := client.getJSON(ctx, "/tickets.json", &v); err != ErrResponseTooLarge {
+		t.Fatalf("expect ErrResponseTooLarge from streaming decode, but got %v", err)
+	}
+
+	size := int64(len(readFixture(filepath.Join(http.MethodGet, "tickets.json"))))
+	client.SetMaxResponseSize(size)
+	if _, _, err := client.GetTickets(ctx, nil); err != nil {
+		t.Fatalf("Body of exactly the max size should be read: %s", err)
+	}
+}
+
+func gzipFixture(t *testing.T, filename string) []byte {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(readFixture(filepath.Join(http.MethodGet, filename))); err != nil {
+		t.Fatalf("Failed to compress fixture: %s", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("Failed to compress fixture: %s", err)
+	}
+	return buf.Bytes()
+}
+
+func TestGzipResponse(t *testing.T) {
+	body := gzipFixture(t, "tickets.json")
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Fatalf("Accept-Encoding expect gzip, but got %s", r.Header.Get("Accept-Encoding"))
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write(body)
+	}))
+	client := newTestClient(mockAPI)
+	client.headers = defaultHeaders
+	defer mockAPI.Close()
+
+	tickets, _, err := client.GetTickets(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get gzipped tickets: %s", err)
+	}
+	if len(tickets) != 2 {
+		t.Fatalf("expected length of tickets is 2, but got %d", len(tickets))
+	}
+}
+
+type gzipTransport struct {
+	body []byte
+}
+
+func (g gzipTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Encoding": []string{"gzip"}},
+		Body:       ioutil.NopCloser(bytes.NewReader(g.body)),
+		Request:    req,
+	}, nil
+}
+
+func TestGzipResponseWithCustomTransport(t *testing.T) {
+	client, _ := NewClient(&http.Client{Transport: gzipTransport{body: gzipFixture(t, "tickets.json")}})
+	client.SetEndpointURL("http://example.invalid")
+
+	tickets, _, err := client.GetTickets(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get gzipped tickets: %s", err)
+	}
+	if len(tickets) != 2 {
+		t.Fatalf("expected length of tickets is 2, but got %d", len(tickets))
+	}
+}
@@ -0,0 +1,274 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// BusinessRules is a snapshot of triggers, automations and views of an account.
+// It's useful to keep business rules as code and detect drift
+type BusinessRules struct {
+	Triggers    []Trigger    `json:"triggers"`
+	Automations []Automation `json:"automations"`
+	Views       []View       `json:"views"`
+}
+
+// Kinds of business rules reported in Change
+const (
+	RuleKindTrigger    = "trigger"
+	RuleKindAutomation = "automation"
+	RuleKindView       = "view"
+)
+
+// Change is a difference of a business rule between two snapshots.
+// Field is a path to the changed field such as "conditions.all[0].value",
+// and is empty when the whole rule was added or removed.
+// Old is nil for an added rule or field and New is nil for a removed one
+type Change struct {
+	Kind  string
+	ID    int64
+	Title string
+	Field string
+	Old   interface{}
+	New   interface{}
+}
+
+// String returns a human readable description of the change
+func (c Change) String() string {
+	switch {
+	case c.Field == "" && c.Old == nil:
+		return fmt.Sprintf("%s %d %q: added", c.Kind, c.ID, c.Title)
+	case c.Field == "" && c.New == nil:
+		return fmt.Sprintf("%s %d %q: removed", c.Kind, c.ID, c.Title)
+	default:
+		return fmt.Sprintf("%s %d %q: %s changed from %v to %v", c.Kind, c.ID, c.Title, c.Field, c.Old, c.New)
+	}
+}
+
+// ruleNoiseFields are fields which change without a change of the rule itself
+var ruleNoiseFields = map[string]bool{
+	"url":        true,
+	"created_at": true,
+	"updated_at": true,
+}
+
+// BusinessRulesAPI an interface containing business rules snapshot methods
+type BusinessRulesAPI interface {
+	ExportBusinessRules(ctx context.Context) (BusinessRules, error)
+}
+
+// ExportBusinessRules fetches every trigger, automation and view of the account
+func (z *Client) ExportBusinessRules(ctx context.Context) (BusinessRules, error) {
+	var rules BusinessRules
+
+	triggerOpts := &TriggerListOptions{PageOptions: PageOptions{PerPage: MaxPerPage}}
+	for {
+		triggers, page, err := z.GetTriggers(ctx, triggerOpts)
+		if err != nil {
+			return BusinessRules{}, err
+		}
+		rules.Triggers = append(rules.Triggers, triggers...)
+		next, ok := page.NextPageNumber()
+		if !ok {
+			break
+		}
+		triggerOpts.Page = next
+	}
+
+	automationOpts := &AutomationListOptions{PageOptions: PageOptions{PerPage: MaxPerPage}}
+	for {
+		automations, page, err := z.GetAutomations(ctx, automationOpts)
+		if err != nil {
+			return BusinessRules{}, err
+		}
+		rules.Automations = append(rules.Automations, automations...)
+		next, ok := page.NextPageNumber()
+		if !ok {
+			break
+		}
+		automationOpts.Page = next
+	}
+
+	viewOpts := &ViewListOptions{PageOptions: PageOptions{PerPage: MaxPerPage}}
+	for {
+		views, page, err := z.GetViewsWithOptions(ctx, viewOpts)
+		if err != nil {
+			return BusinessRules{}, err
+		}
+		rules.Views = append(rules.Views, views...)
+		next, ok := page.NextPageNumber()
+		if !ok {
+			break
+		}
+		viewOpts.Page = next
+	}
+
+	return rules, nil
+}
+
+// DiffBusinessRules reports field-level differences from a to b.
+// Rules are matched by their kind and ID, and changes are ordered by
+// kind, ID and field. Fields are named by their JSON keys
+func DiffBusinessRules(a, b BusinessRules) []Change {
+	var changes []Change
+
+	for _, kind := range []string{RuleKindTrigger, RuleKindAutomation, RuleKindView} {
+		before, after := a.rules(kind), b.rules(kind)
+
+		ids := make([]int64, 0, len(before)+len(after))
+		for id := range before {
+			ids = append(ids, id)
+		}
+		for id := range after {
+			if _, ok := before[id]; !ok {
+				ids = append(ids, id)
+			}
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+		for _, id := range ids {
+			old, hasOld := before[id]
+			cur, hasCur := after[id]
+			switch {
+			case !hasOld:
+				changes = append(changes, Change{Kind: kind, ID: id, Title: ruleTitle(cur), New: cur.Interface()})
+			case !hasCur:
+				changes = append(changes, Change{Kind: kind, ID: id, Title: ruleTitle(old), Old: old.Interface()})
+			default:
+				title := ruleTitle(cur)
+				diffValue("", old, cur, func(field string, o, n interface{}) {
+					changes = append(changes, Change{Kind: kind, ID: id, Title: title, Field: field, Old: o, New: n})
+				})
+			}
+		}
+	}
+
+	return changes
+}
+
+// rules returns the rules of the kind keyed by ID
+func (r BusinessRules) rules(kind string) map[int64]reflect.Value {
+	var list reflect.Value
+	switch kind {
+	case RuleKindTrigger:
+		list = reflect.ValueOf(r.Triggers)
+	case RuleKindAutomation:
+		list = reflect.ValueOf(r.Automations)
+	case RuleKindView:
+		list = reflect.ValueOf(r.Views)
+	}
+
+	rules := make(map[int64]reflect.Value, list.Len())
+	for i := 0; i < list.Len(); i++ {
+		rule := list.Index(i)
+		rules[rule.FieldByName("ID").Int()] = rule
+	}
+	return rules
+}
+
+func ruleTitle(rule reflect.Value) string {
+	return rule.FieldByName("Title").String()
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// diffValue walks two values of the same type and calls report for every
+// leaf which differs. Struct fields are named by their JSON keys, and fields
+// which aren't sent as JSON or are in ruleNoiseFields of a rule are skipped
+func diffValue(path string, a, b reflect.Value, report func(field string, old, new interface{})) {
+	if !a.IsValid() || !b.IsValid() {
+		if a.IsValid() || b.IsValid() {
+			report(path, valueInterface(a), valueInterface(b))
+		}
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if a.IsNil() && b.IsNil() {
+			return
+		}
+		if a.IsNil() || b.IsNil() || a.Elem().Type() != b.Elem().Type() {
+			report(path, valueInterface(a), valueInterface(b))
+			return
+		}
+		diffValue(path, a.Elem(), b.Elem(), report)
+		return
+	case reflect.Struct:
+		if a.Type() == timeType {
+			if !a.Interface().(time.Time).Equal(b.Interface().(time.Time)) {
+				report(path, a.Interface(), b.Interface())
+			}
+			return
+		}
+		for i := 0; i < a.NumField(); i++ {
+			f := a.Type().Field(i)
+			name := strings.Split(f.Tag.Get("json"), ",")[0]
+			if f.PkgPath != "" || name == "-" || (path == "" && ruleNoiseFields[name]) {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			field := name
+			if path != "" {
+				field = path + "." + name
+			}
+			diffValue(field, a.Field(i), b.Field(i), report)
+		}
+		return
+	case reflect.Slice, reflect.Array:
+		// A missing list and an empty list mean the same thing
+		n := a.Len()
+		if b.Len() > n {
+			n = b.Len()
+		}
+		for i := 0; i < n; i++ {
+			var ai, bi reflect.Value
+			if i < a.Len() {
+				ai = a.Index(i)
+			}
+			if i < b.Len() {
+				bi = b.Index(i)
+			}
+			diffValue(fmt.Sprintf("%s[%d]", path, i), ai, bi, report)
+		}
+		return
+	case reflect.Map:
+		keys := append(a.MapKeys(), b.MapKeys()...)
+		sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+		for i, k := range keys {
+			if i > 0 && fmt.Sprint(keys[i-1]) == fmt.Sprint(k) {
+				continue
+			}
+			field := fmt.Sprint(k)
+			if path != "" {
+				field = path + "." + field
+			}
+			diffValue(field, a.MapIndex(k), b.MapIndex(k), report)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+		report(path, a.Interface(), b.Interface())
+	}
+}
+
+// valueInterface returns the value held by v, or nil for an invalid or nil value
+func valueInterface(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+		return nil
+	}
+	if v.Kind() == reflect.Interface {
+		return v.Elem().Interface()
+	}
+	return v.Interface()
+}
@@ -0,0 +1,123 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newBusinessRulesMockAPI(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/triggers.json", "/automations.json", "/views.json":
+			w.Write(readFixture(filepath.Join(http.MethodGet, filepath.Base(r.URL.Path))))
+		default:
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+	}))
+}
+
+func TestExportBusinessRules(t *testing.T) {
+	mockAPI := newBusinessRulesMockAPI(t)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	rules, err := client.ExportBusinessRules(ctx)
+	if err != nil {
+		t.Fatalf("Failed to export business rules: %s", err)
+	}
+
+	if len(rules.Triggers) != 8 {
+		t.Fatalf("expected length of triggers is 8, but got %d", len(rules.Triggers))
+	}
+	if len(rules.Automations) != 3 {
+		t.Fatalf("expected length of automations is 3, but got %d", len(rules.Automations))
+	}
+	if len(rules.Views) != 2 {
+		t.Fatalf("expected length of views is 2, but got %d", len(rules.Views))
+	}
+}
+
+func TestDiffBusinessRules(t *testing.T) {
+	mockAPI := newBusinessRulesMockAPI(t)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	before, err := client.ExportBusinessRules(ctx)
+	if err != nil {
+		t.Fatalf("Failed to export business rules: %s", err)
+	}
+
+	// Deep copy the snapshot so that the change doesn't leak into before
+	var after BusinessRules
+	b, _ := json.Marshal(before)
+	if err := json.Unmarshal(b, &after); err != nil {
+		t.Fatalf("Failed to copy business rules: %s", err)
+	}
+
+	changes := DiffBusinessRules(before, after)
+	if len(changes) != 0 {
+		t.Fatalf("expected no changes between equal snapshots, but got %v", changes)
+	}
+
+	after.Triggers[0].Conditions.All[1].Value = "closed"
+
+	changes = DiffBusinessRules(before, after)
+	if len(changes) != 1 {
+		t.Fatalf("expected length of changes is 1, but got %d: %v", len(changes), changes)
+	}
+
+	c := changes[0]
+	if c.Kind != RuleKindTrigger || c.ID != 360056295714 {
+		t.Fatalf("change has unexpected rule %s %d", c.Kind, c.ID)
+	}
+	if c.Field != "conditions.all[1].value" {
+		t.Fatalf("change has unexpected field %s", c.Field)
+	}
+	if c.Old != "solved" || c.New != "closed" {
+		t.Fatalf("change expect solved -> closed, but got %v -> %v", c.Old, c.New)
+	}
+}
+
+func TestDiffBusinessRulesAddedAndRemoved(t *testing.T) {
+	before := BusinessRules{Automations: []Automation{{ID: 1, Title: "Close solved"}}}
+	after := BusinessRules{Views: []View{{ID: 2, Title: "Unassigned"}}}
+
+	changes := DiffBusinessRules(before, after)
+	if len(changes) != 2 {
+		t.Fatalf("expected length of changes is 2, but got %d: %v", len(changes), changes)
+	}
+
+	if c := changes[0]; c.Kind != RuleKindAutomation || c.ID != 1 || c.Field != "" || c.New != nil {
+		t.Fatalf("expected automation 1 to be removed, but got %v", c)
+	}
+	if c := changes[1]; c.Kind != RuleKindView || c.ID != 2 || c.Field != "" || c.Old != nil {
+		t.Fatalf("expected view 2 to be added, but got %v", c)
+	}
+}
+
+func TestDiffBusinessRulesActionValue(t *testing.T) {
+	now := time.Now()
+	before := BusinessRules{Triggers: []Trigger{{
+		ID:        1,
+		Title:     "Notify requester",
+		Actions:   []TriggerAction{{Field: "notification_user", Value: []interface{}{"requester_id", "Subject"}}},
+		UpdatedAt: &now,
+	}}}
+	after := BusinessRules{Triggers: []Trigger{{
+		ID:      1,
+		Title:   "Notify requester",
+		Actions: []TriggerAction{{Field: "notification_user", Value: []interface{}{"requester_id", "New subject"}}},
+	}}}
+
+	changes := DiffBusinessRules(before, after)
+	if len(changes) != 1 {
+		t.Fatalf("expected length of changes is 1, but got %d: %v", len(changes), changes)
+	}
+	if c := changes[0]; c.Field != "actions[0].value[1]" || c.Old != "Subject" || c.New != "New subject" {
+		t.Fatalf("change has unexpected field or values: %v", c)
+	}
+}
@@ -0,0 +1,393 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Kinds of resources cached by CachedClient
+const (
+	cacheKindGroup        = "group"
+	cacheKindTicketField  = "ticket_field"
+	cacheKindTicketForm   = "ticket_form"
+	cacheKindBrand        = "brand"
+	cacheKindCustomStatus = "custom_status"
+)
+
+// CachedClient is a Client which caches reads of slowly changing reference data,
+// which are groups, ticket fields, ticket forms, brands and custom statuses.
+// Results are reused until ttl has passed since they were fetched, and a write
+// to a resource through CachedClient drops every cached result of its kind.
+// Changes made by others are seen after ttl at most.
+// Helpers composed of these reads, e.g. GetTicketFormByName, use the cache as well.
+// Other methods are passed through to Client. It's safe for concurrent use.
+// Returned values are copies, so changing them doesn't change the cache
+type CachedClient struct {
+	*Client
+
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]map[string]cacheEntry
+}
+
+type cacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// cachedList is a cached result of a list method
+type cachedList struct {
+	list interface{}
+	page Page
+}
+
+var _ API = (*CachedClient)(nil)
+
+// NewCachedClient wraps c with a cache whose results live for ttl
+func NewCachedClient(c *Client, ttl time.Duration) *CachedClient {
+	return &CachedClient{
+		Client:  c,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[string]map[string]cacheEntry{},
+	}
+}
+
+// Purge drops every cached result
+func (c *CachedClient) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = map[string]map[string]cacheEntry{}
+}
+
+// cached returns the cached value of the key, or calls fetch and caches its value.
+// Errors are not cached
+func (c *CachedClient) cached(kind, key string, fetch func() (interface{}, error)) (interface{}, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[kind][key]
+	c.mu.Unlock()
+	if ok && c.now().Before(entry.expiresAt) {
+		return entry.value, nil
+	}
+
+	v, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries[kind] == nil {
+		c.entries[kind] = map[string]cacheEntry{}
+	}
+	c.entries[kind][key] = cacheEntry{value: v, expiresAt: c.now().Add(c.ttl)}
+	return v, nil
+}
+
+// invalidate drops cached results of the kind
+func (c *CachedClient) invalidate(kind string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, kind)
+}
+
+// GetGroups fetches group list through the cache
+func (c *CachedClient) GetGroups(ctx context.Context) ([]Group, Page, error) {
+	v, err := c.cached(cacheKindGroup, "list", func() (interface{}, error) {
+		groups, page, err := c.Client.GetGroups(ctx)
+		return cachedList{groups, page}, err
+	})
+	if err != nil {
+		return nil, Page{}, err
+	}
+	l := v.(cachedList)
+	return append([]Group(nil), l.list.([]Group)...), l.page, nil
+}
+
+// GetGroup gets a group through the cache
+func (c *CachedClient) GetGroup(ctx context.Context, groupID int64) (Group, error) {
+	v, err := c.cached(cacheKindGroup, fmt.Sprint(groupID), func() (interface{}, error) {
+		return c.Client.GetGroup(ctx, groupID)
+	})
+	if err != nil {
+		return Group{}, err
+	}
+	return v.(Group), nil
+}
+
+// GetUserDefaultGroup fetches the default group of the user.
+// Memberships of the user are always fetched, and the group is read through the cache
+func (c *CachedClient) GetUserDefaultGroup(ctx context.Context, userID int64) (Group, error) {
+	return getUserDefaultGroup(ctx, c, userID)
+}
+
+// CreateGroup creates a group and invalidates cached groups
+func (c *CachedClient) CreateGroup(ctx context.Context, group Group) (Group, error) {
+	defer c.invalidate(cacheKindGroup)
+	return c.Client.CreateGroup(ctx, group)
+}
+
+// UpdateGroup updates a group and invalidates cached groups
+func (c *CachedClient) UpdateGroup(ctx context.Context, groupID int64, group Group) (Group, error) {
+	defer c.invalidate(cacheKindGroup)
+	return c.Client.UpdateGroup(ctx, groupID, group)
+}
+
+// DeleteGroup deletes a group and invalidates cached groups
+func (c *CachedClient) DeleteGroup(ctx context.Context, groupID int64) error {
+	defer c.invalidate(cacheKindGroup)
+	return c.Client.DeleteGroup(ctx, groupID)
+}
+
+// GetTicketFields fetches ticket field list through the cache
+func (c *CachedClient) GetTicketFields(ctx context.Context) ([]TicketField, Page, error) {
+	v, err := c.cached(cacheKindTicketField, "list", func() (interface{}, error) {
+		fields, page, err := c.Client.GetTicketFields(ctx)
+		return cachedList{fields, page}, err
+	})
+	if err != nil {
+		return nil, Page{}, err
+	}
+	l := v.(cachedList)
+	return cloneTicketFields(l.list.([]TicketField)), l.page, nil
+}
+
+// GetTicketField gets a ticket field through the cache
+func (c *CachedClient) GetTicketField(ctx context.Context, fieldID int64) (TicketField, error) {
+	v, err := c.cached(cacheKindTicketField, fmt.Sprint(fieldID), func() (interface{}, error) {
+		return c.Client.GetTicketField(ctx, fieldID)
+	})
+	if err != nil {
+		return TicketField{}, err
+	}
+	return cloneTicketField(v.(TicketField)), nil
+}
+
+// GetTicketFieldsForForm returns the ticket fields of the form with the form and fields in the cache
+func (c *CachedClient) GetTicketFieldsForForm(ctx context.Context, formID int64) ([]TicketField, error) {
+	form, err := c.GetTicketForm(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := c.cached(cacheKindTicketField, "all", func() (interface{}, error) {
+		return c.Client.getAllTicketFields(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cloneTicketFields(fieldsOfForm(form, v.([]TicketField))), nil
+}
+
+// ResolveCustomFieldValue resolves the value with the ticket field in the cache
+func (c *CachedClient) ResolveCustomFieldValue(ctx context.Context, fieldID int64, value string) (string, error) {
+	return resolveCustomFieldValue(ctx, c, c.Client.fieldOptions, fieldID, value)
+}
+
+// CreateTicketField creates a ticket field and invalidates cached ticket fields
+func (c *CachedClient) CreateTicketField(ctx context.Context, ticketField TicketField) (TicketField, error) {
+	defer c.invalidate(cacheKindTicketField)
+	return c.Client.CreateTicketField(ctx, ticketField)
+}
+
+// UpdateTicketField updates a ticket field and invalidates cached ticket fields
+func (c *CachedClient) UpdateTicketField(ctx context.Context, fieldID int64, field TicketField) (TicketField, error) {
+	defer c.invalidate(cacheKindTicketField)
+	return c.Client.UpdateTicketField(ctx, fieldID, field)
+}
+
+// DeleteTicketField deletes a ticket field and invalidates cached ticket fields
+func (c *CachedClient) DeleteTicketField(ctx context.Context, fieldID int64) error {
+	defer c.invalidate(cacheKindTicketField)
+	return c.Client.DeleteTicketField(ctx, fieldID)
+}
+
+// GetTicketForms fetches ticket forms through the cache. Results are cached per options
+func (c *CachedClient) GetTicketForms(ctx context.Context, options *TicketFormListOptions) ([]TicketForm, Page, error) {
+	tmp := options
+	if tmp == nil {
+		tmp = &TicketFormListOptions{}
+	}
+	key, err := addOptions("list", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	v, err := c.cached(cacheKindTicketForm, key, func() (interface{}, error) {
+		forms, page, err := c.Client.GetTicketForms(ctx, options)
+		return cachedList{forms, page}, err
+	})
+	if err != nil {
+		return nil, Page{}, err
+	}
+	l := v.(cachedList)
+	return cloneTicketForms(l.list.([]TicketForm)), l.page, nil
+}
+
+// GetTicketForm gets a ticket form through the cache
+func (c *CachedClient) GetTicketForm(ctx context.Context, id int64) (TicketForm, error) {
+	v, err := c.cached(cacheKindTicketForm, fmt.Sprint(id), func() (interface{}, error) {
+		return c.Client.GetTicketForm(ctx, id)
+	})
+	if err != nil {
+		return TicketForm{}, err
+	}
+	return cloneTicketForm(v.(TicketForm)), nil
+}
+
+// GetTicketFormByName returns the ticket form of the name with forms in the cache
+func (c *CachedClient) GetTicketFormByName(ctx context.Context, name string) (TicketForm, error) {
+	return getTicketFormByName(ctx, c, name)
+}
+
+// GetDefaultTicketForm returns the default ticket form of the brand with forms in the cache
+func (c *CachedClient) GetDefaultTicketForm(ctx context.Context, brandID int64) (TicketForm, error) {
+	return getDefaultTicketForm(ctx, c, brandID)
+}
+
+// CreateTicketForm creates a ticket form and invalidates cached ticket forms
+func (c *CachedClient) CreateTicketForm(ctx context.Context, ticketForm TicketForm) (TicketForm, error) {
+	defer c.invalidate(cacheKindTicketForm)
+	return c.Client.CreateTicketForm(ctx, ticketForm)
+}
+
+// UpdateTicketForm updates a ticket form and invalidates cached ticket forms
+func (c *CachedClient) UpdateTicketForm(ctx context.Context, id int64, form TicketForm) (TicketForm, error) {
+	defer c.invalidate(cacheKindTicketForm)
+	return c.Client.UpdateTicketForm(ctx, id, form)
+}
+
+// DeleteTicketForm deletes a ticket form and invalidates cached ticket forms
+func (c *CachedClient) DeleteTicketForm(ctx context.Context, id int64) error {
+	defer c.invalidate(cacheKindTicketForm)
+	return c.Client.DeleteTicketForm(ctx, id)
+}
+
+// GetBrand gets a brand through the cache
+func (c *CachedClient) GetBrand(ctx context.Context, brandID int64) (Brand, error) {
+	v, err := c.cached(cacheKindBrand, fmt.Sprint(brandID), func() (interface{}, error) {
+		return c.Client.GetBrand(ctx, brandID)
+	})
+	if err != nil {
+		return Brand{}, err
+	}
+	return cloneBrand(v.(Brand)), nil
+}
+
+// CreateBrand creates a brand and invalidates cached brands
+func (c *CachedClient) CreateBrand(ctx context.Context, brand Brand) (Brand, error) {
+	defer c.invalidate(cacheKindBrand)
+	return c.Client.CreateBrand(ctx, brand)
+}
+
+// UpdateBrand updates a brand and invalidates cached brands
+func (c *CachedClient) UpdateBrand(ctx context.Context, brandID int64, brand Brand) (Brand, error) {
+	defer c.invalidate(cacheKindBrand)
+	return c.Client.UpdateBrand(ctx, brandID, brand)
+}
+
+// DeleteBrand deletes a brand and invalidates cached brands
+func (c *CachedClient) DeleteBrand(ctx context.Context, brandID int64) error {
+	defer c.invalidate(cacheKindBrand)
+	return c.Client.DeleteBrand(ctx, brandID)
+}
+
+// GetCustomStatuses fetches custom ticket statuses through the cache. Results are cached per options
+func (c *CachedClient) GetCustomStatuses(ctx context.Context, opts *CustomStatusListOptions) ([]CustomStatus, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &CustomStatusListOptions{}
+	}
+	key, err := addOptions("list", tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := c.cached(cacheKindCustomStatus, key, func() (interface{}, error) {
+		return c.Client.GetCustomStatuses(ctx, opts)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append([]CustomStatus(nil), v.([]CustomStatus)...), nil
+}
+
+// GetCustomStatus gets a custom ticket status through the cache
+func (c *CachedClient) GetCustomStatus(ctx context.Context, id int64) (CustomStatus, error) {
+	v, err := c.cached(cacheKindCustomStatus, fmt.Sprint(id), func() (interface{}, error) {
+		return c.Client.GetCustomStatus(ctx, id)
+	})
+	if err != nil {
+		return CustomStatus{}, err
+	}
+	return v.(CustomStatus), nil
+}
+
+// CreateCustomStatus creates a custom ticket status and invalidates cached custom statuses
+func (c *CachedClient) CreateCustomStatus(ctx context.Context, status CustomStatus) (CustomStatus, error) {
+	defer c.invalidate(cacheKindCustomStatus)
+	return c.Client.CreateCustomStatus(ctx, status)
+}
+
+// UpdateCustomStatus updates a custom ticket status and invalidates cached custom statuses
+func (c *CachedClient) UpdateCustomStatus(ctx context.Context, id int64, status CustomStatus) (CustomStatus, error) {
+	defer c.invalidate(cacheKindCustomStatus)
+	return c.Client.UpdateCustomStatus(ctx, id, status)
+}
+
+// cloneTicketField copies the field with its slices and pointers so that
+// the copy shares no memory with the cache.
+// Groups and custom statuses have no such fields and are copied by value
+func cloneTicketField(f TicketField) TicketField {
+	f.CreatedAt = cloneTime(f.CreatedAt)
+	f.UpdatedAt = cloneTime(f.UpdatedAt)
+	f.SystemFieldOptions = append([]TicketFieldSystemFieldOption(nil), f.SystemFieldOptions...)
+	f.CustomFieldOptions = append([]CustomFieldOption(nil), f.CustomFieldOptions...)
+	return f
+}
+
+func cloneTicketFields(fields []TicketField) []TicketField {
+	if fields == nil {
+		return nil
+	}
+	cloned := make([]TicketField, len(fields))
+	for i, f := range fields {
+		cloned[i] = cloneTicketField(f)
+	}
+	return cloned
+}
+
+func cloneTicketForm(f TicketForm) TicketForm {
+	f.TicketFieldIDs = append([]int64(nil), f.TicketFieldIDs...)
+	f.RestrictedBrandIDs = append([]int64(nil), f.RestrictedBrandIDs...)
+	return f
+}
+
+func cloneTicketForms(forms []TicketForm) []TicketForm {
+	if forms == nil {
+		return nil
+	}
+	cloned := make([]TicketForm, len(forms))
+	for i, f := range forms {
+		cloned[i] = cloneTicketForm(f)
+	}
+	return cloned
+}
+
+func cloneBrand(b Brand) Brand {
+	b.TicketFormIDs = append([]int64(nil), b.TicketFormIDs...)
+	b.Logo.Thumbnails = append([]Photo(nil), b.Logo.Thumbnails...)
+	return b
+}
+
+func cloneTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	return &c
+}
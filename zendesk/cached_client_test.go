@@ -0,0 +1,183 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newCountingMockAPI(t *testing.T, counts map[string]int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		counts[r.Method+" "+r.URL.Path]++
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/groups.json":
+			w.Write(readFixture(filepath.Join(http.MethodGet, "groups.json")))
+		case r.Method == http.MethodGet && r.URL.Path == "/custom_statuses.json":
+			w.Write(readFixture(filepath.Join(http.MethodGet, "custom_statuses.json")))
+		case r.Method == http.MethodGet && r.URL.Path == "/ticket_forms.json":
+			w.Write(readFixture(filepath.Join(http.MethodGet, "ticket_forms.json")))
+		case r.Method == http.MethodGet && r.URL.Path == "/ticket_forms/47.json":
+			w.Write(readFixture(filepath.Join(http.MethodGet, "ticket_form.json")))
+		case r.Method == http.MethodGet && r.URL.Path == "/ticket_fields.json":
+			w.Write(readFixture(filepath.Join(http.MethodGet, "ticket_fields.json")))
+		case r.Method == http.MethodGet && r.URL.Path == "/ticket_fields/360011737434.json":
+			w.Write(readFixture(filepath.Join(http.MethodGet, "ticket_field.json")))
+		case r.Method == http.MethodPost && r.URL.Path == "/groups.json":
+			w.WriteHeader(http.StatusCreated)
+			w.Write(readFixture(filepath.Join(http.MethodPost, "groups.json")))
+		default:
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+}
+
+func TestCachedClientReadWithinTTL(t *testing.T) {
+	counts := map[string]int{}
+	mockAPI := newCountingMockAPI(t, counts)
+	defer mockAPI.Close()
+
+	client := NewCachedClient(newTestClient(mockAPI), time.Minute)
+	for i := 0; i < 2; i++ {
+		groups, _, err := client.GetGroups(ctx)
+		if err != nil {
+			t.Fatalf("Failed to get groups: %s", err)
+		}
+		if len(groups) == 0 {
+			t.Fatal("expected groups to be returned")
+		}
+	}
+
+	if n := counts["GET /groups.json"]; n != 1 {
+		t.Fatalf("expected 1 request for groups, but got %d", n)
+	}
+}
+
+func TestCachedClientCompositeHelpersWithinTTL(t *testing.T) {
+	counts := map[string]int{}
+	mockAPI := newCountingMockAPI(t, counts)
+	defer mockAPI.Close()
+
+	client := NewCachedClient(newTestClient(mockAPI), time.Minute)
+	helpers := func() {
+		if _, err := client.GetTicketFormByName(ctx, "デフォルトのチケットフォーム"); err != nil {
+			t.Fatalf("Failed to get ticket form by name: %s", err)
+		}
+		if _, err := client.GetDefaultTicketForm(ctx, 360000015234); err != nil && err != ErrTicketFormNotFound {
+			t.Fatalf("Failed to get default ticket form: %s", err)
+		}
+		if _, err := client.GetTicketFieldsForForm(ctx, 47); err != nil {
+			t.Fatalf("Failed to get ticket fields for form: %s", err)
+		}
+		if _, err := client.ResolveCustomFieldValue(ctx, 360011737434, "xxx"); err != nil && err != ErrCustomFieldOptionNotFound {
+			t.Fatalf("Failed to resolve custom field value: %s", err)
+		}
+	}
+
+	helpers()
+	for k := range counts {
+		delete(counts, k)
+	}
+	helpers()
+
+	if len(counts) != 0 {
+		t.Fatalf("expected no request within ttl, but got %v", counts)
+	}
+}
+
+func TestCachedClientExpires(t *testing.T) {
+	counts := map[string]int{}
+	mockAPI := newCountingMockAPI(t, counts)
+	defer mockAPI.Close()
+
+	now := time.Date(2019, 6, 6, 10, 0, 0, 0, time.UTC)
+	client := NewCachedClient(newTestClient(mockAPI), time.Minute)
+	client.now = func() time.Time { return now }
+
+	active := true
+	opts := &CustomStatusListOptions{Active: &active}
+	for _, d := range []time.Duration{0, 30 * time.Second, 2 * time.Minute} {
+		now = now.Add(d)
+		if _, err := client.GetCustomStatuses(ctx, opts); err != nil {
+			t.Fatalf("Failed to get custom statuses: %s", err)
+		}
+	}
+
+	if n := counts["GET /custom_statuses.json"]; n != 2 {
+		t.Fatalf("expected 2 requests for custom statuses, but got %d", n)
+	}
+}
+
+func TestCachedClientWriteInvalidates(t *testing.T) {
+	counts := map[string]int{}
+	mockAPI := newCountingMockAPI(t, counts)
+	defer mockAPI.Close()
+
+	client := NewCachedClient(newTestClient(mockAPI), time.Minute)
+	if _, _, err := client.GetGroups(ctx); err != nil {
+		t.Fatalf("Failed to get groups: %s", err)
+	}
+	if _, err := client.CreateGroup(ctx, Group{Name: "Support"}); err != nil {
+		t.Fatalf("Failed to create group: %s", err)
+	}
+	if _, _, err := client.GetGroups(ctx); err != nil {
+		t.Fatalf("Failed to get groups: %s", err)
+	}
+
+	if n := counts["GET /groups.json"]; n != 2 {
+		t.Fatalf("expected groups to be fetched again after the write, but got %d requests", n)
+	}
+}
+
+func TestCachedClientReturnsCopies(t *testing.T) {
+	counts := map[string]int{}
+	mockAPI := newCountingMockAPI(t, counts)
+	defer mockAPI.Close()
+
+	client := NewCachedClient(newTestClient(mockAPI), time.Minute)
+
+	form, err := client.GetTicketForm(ctx, 47)
+	if err != nil {
+		t.Fatalf("Failed to get ticket form: %s", err)
+	}
+	expected := form.TicketFieldIDs[0]
+	form.TicketFieldIDs[0] = -1
+
+	form, err = client.GetTicketForm(ctx, 47)
+	if err != nil {
+		t.Fatalf("Failed to get ticket form: %s", err)
+	}
+	if form.TicketFieldIDs[0] != expected {
+		t.Fatalf("cached ticket form was changed through a returned value: %v", form.TicketFieldIDs)
+	}
+
+	fields, _, err := client.GetTicketFields(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get ticket fields: %s", err)
+	}
+	i := -1
+	for j, f := range fields {
+		if len(f.CustomFieldOptions) > 0 {
+			i = j
+			break
+		}
+	}
+	if i < 0 {
+		t.Fatal("expected a ticket field with custom field options")
+	}
+	name := fields[i].CustomFieldOptions[0].Name
+	fields[i].CustomFieldOptions[0].Name = "changed"
+
+	fields, _, err = client.GetTicketFields(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get ticket fields: %s", err)
+	}
+	if fields[i].CustomFieldOptions[0].Name != name {
+		t.Fatalf("cached ticket field was changed through a returned value: %v", fields[i].CustomFieldOptions)
+	}
+
+	if counts["GET /ticket_forms/47.json"] != 1 || counts["GET /ticket_fields.json"] != 1 {
+		t.Fatalf("expected results to be cached, but got requests %v", counts)
+	}
+}
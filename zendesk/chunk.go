@@ -0,0 +1,32 @@
+package zendesk
+
+// manyLimit is the max number of ids or items accepted by *_many endpoints
+const manyLimit = 100
+
+// span is a half-open range [start, end) of slice indexes
+type span struct {
+	start, end int
+}
+
+// chunk splits n items into spans of at most size items.
+// It works with a slice of any type by slicing it with the returned spans
+func chunk(n, size int) []span {
+	var spans []span
+	for start := 0; start < n; start += size {
+		end := start + size
+		if end > n {
+			end = n
+		}
+		spans = append(spans, span{start: start, end: end})
+	}
+	return spans
+}
+
+// chunkIDs splits ids into batches of at most size ids
+func chunkIDs(ids []int64, size int) [][]int64 {
+	var batches [][]int64
+	for _, s := range chunk(len(ids), size) {
+		batches = append(batches, ids[s.start:s.end])
+	}
+	return batches
+}
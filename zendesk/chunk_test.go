@@ -0,0 +1,39 @@
+package zendesk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChunk(t *testing.T) {
+	cases := []struct {
+		n        int
+		expected []span
+	}{
+		{0, nil},
+		{1, []span{{0, 1}}},
+		{100, []span{{0, 100}}},
+		{201, []span{{0, 100}, {100, 200}, {200, 201}}},
+	}
+
+	for _, c := range cases {
+		if actual := chunk(c.n, 100); !reflect.DeepEqual(actual, c.expected) {
+			t.Fatalf("chunk(%d) expect %v, but got %v", c.n, c.expected, actual)
+		}
+	}
+}
+
+func TestChunkIDs(t *testing.T) {
+	ids := make([]int64, 201)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	batches := chunkIDs(ids, 100)
+	if len(batches) != 3 {
+		t.Fatalf("expected number of batches is 3, but got %d", len(batches))
+	}
+	if batches[2][0] != 201 || len(batches[1]) != 100 {
+		t.Fatalf("Batches were not split as expected: %v", batches)
+	}
+}
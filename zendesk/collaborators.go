@@ -1,6 +1,7 @@
 package zendesk
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
@@ -12,6 +13,33 @@ type Collaborator struct {
 	Email string `json:"email,omitempty"`
 }
 
+// Actions of EmailCC and Follower mutations
+const (
+	MutationActionPut    = "put"
+	MutationActionDelete = "delete"
+)
+
+// EmailCC is a mutation of ticket email CCs.
+// User is identified by UserID or UserEmail, and Action is "put" or "delete"
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#setting-email-ccs
+type EmailCC struct {
+	UserID    int64  `json:"user_id,omitempty"`
+	UserEmail string `json:"user_email,omitempty"`
+	UserName  string `json:"user_name,omitempty"`
+	Action    string `json:"action,omitempty"`
+}
+
+// Follower is a mutation of ticket followers.
+// User is identified by UserID or UserEmail, and Action is "put" or "delete"
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#setting-followers
+type Follower struct {
+	UserID    int64  `json:"user_id,omitempty"`
+	UserEmail string `json:"user_email,omitempty"`
+	Action    string `json:"action,omitempty"`
+}
+
 // Collaborators hold array of interface which can take Collaborator
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/tickets#setting-collaborators
@@ -69,8 +97,9 @@ func (c *Collaborators) Append(i interface{}) error {
 	return nil
 }
 
-// MarshalJSON is marshaller for Collaborators
-func (c *Collaborators) MarshalJSON() ([]byte, error) {
+// MarshalJSON is marshaller for Collaborators.
+// It has a value receiver so that a Ticket value is marshaled with its collaborators
+func (c Collaborators) MarshalJSON() ([]byte, error) {
 	return json.Marshal(c.collaborators)
 }
 
@@ -100,3 +129,88 @@ func (c *Collaborators) UnmarshalJSON(b []byte) error {
 	c.collaborators = newCollaborators.List()
 	return nil
 }
+
+// CollaboratorAPI an interface containing ticket collaborator related methods
+type CollaboratorAPI interface {
+	GetTicketCollaborators(ctx context.Context, ticketID int64) ([]User, error)
+	GetTicketFollowers(ctx context.Context, ticketID int64) ([]User, error)
+	GetTicketEmailCCs(ctx context.Context, ticketID int64) ([]User, error)
+	SetTicketCollaborators(ctx context.Context, ticketID int64, userIDs []int64) (Ticket, error)
+	SetTicketFollowers(ctx context.Context, ticketID int64, userIDs []int64) (Ticket, error)
+	SetTicketEmailCCs(ctx context.Context, ticketID int64, userIDs []int64) (Ticket, error)
+}
+
+// GetTicketCollaborators get collaborators of the ticket as users
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#list-collaborators-for-a-ticket
+func (z *Client) GetTicketCollaborators(ctx context.Context, ticketID int64) ([]User, error) {
+	return z.getTicketUsers(ctx, fmt.Sprintf("/tickets/%d/collaborators.json", ticketID))
+}
+
+// GetTicketFollowers get followers of the ticket as users
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#list-followers-for-a-ticket
+func (z *Client) GetTicketFollowers(ctx context.Context, ticketID int64) ([]User, error) {
+	return z.getTicketUsers(ctx, fmt.Sprintf("/tickets/%d/followers.json", ticketID))
+}
+
+// GetTicketEmailCCs get email CCs of the ticket as users
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#list-email-ccs-for-a-ticket
+func (z *Client) GetTicketEmailCCs(ctx context.Context, ticketID int64) ([]User, error) {
+	return z.getTicketUsers(ctx, fmt.Sprintf("/tickets/%d/email_ccs.json", ticketID))
+}
+
+func (z *Client) getTicketUsers(ctx context.Context, path string) ([]User, error) {
+	var result struct {
+		Users []User `json:"users"`
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Users, nil
+}
+
+// SetTicketCollaborators replaces the collaborators of the ticket with the users.
+// An empty userIDs removes every collaborator
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#setting-collaborators
+func (z *Client) SetTicketCollaborators(ctx context.Context, ticketID int64, userIDs []int64) (Ticket, error) {
+	return z.setTicketUsers(ctx, ticketID, "collaborator_ids", userIDs)
+}
+
+// SetTicketFollowers replaces the followers of the ticket with the users.
+// An empty userIDs removes every follower
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#setting-followers
+func (z *Client) SetTicketFollowers(ctx context.Context, ticketID int64, userIDs []int64) (Ticket, error) {
+	return z.setTicketUsers(ctx, ticketID, "follower_ids", userIDs)
+}
+
+// SetTicketEmailCCs replaces the email CCs of the ticket with the users.
+// An empty userIDs removes every email CC
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#setting-email-ccs
+func (z *Client) SetTicketEmailCCs(ctx context.Context, ticketID int64, userIDs []int64) (Ticket, error) {
+	return z.setTicketUsers(ctx, ticketID, "email_cc_ids", userIDs)
+}
+
+// setTicketUsers sends only the id list of key, so that other fields of the ticket are kept
+func (z *Client) setTicketUsers(ctx context.Context, ticketID int64, key string, userIDs []int64) (Ticket, error) {
+	// A nil slice would be sent as null, but clearing the set requires []
+	if userIDs == nil {
+		userIDs = []int64{}
+	}
+
+	data := map[string]map[string][]int64{
+		"ticket": {key: userIDs},
+	}
+	return z.updateTicket(ctx, ticketID, data)
+}
@@ -1,6 +1,11 @@
 package zendesk
 
 import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"reflect"
 	"testing"
 )
@@ -47,3 +52,85 @@ func TestCanBeMarshalled(t *testing.T) {
 		t.Fatalf("Json output %s did not match expected output %s", out, collaboratorListJson)
 	}
 }
+
+func TestGetTicketCollaborators(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_collaborators.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, err := client.GetTicketCollaborators(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to get ticket collaborators: %s", err)
+	}
+
+	if len(users) != 2 {
+		t.Fatalf("expected length of collaborators is 2, but got %d", len(users))
+	}
+	if users[0].ID != 223443 || users[1].Email != "peter@example.com" {
+		t.Fatalf("Collaborators were not parsed as expected: %v", users)
+	}
+}
+
+func TestGetTicketFollowersAndEmailCCs(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tickets/2/followers.json", "/tickets/2/email_ccs.json":
+		default:
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "ticket_collaborators.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	followers, err := client.GetTicketFollowers(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to get ticket followers: %s", err)
+	}
+	if len(followers) != 2 {
+		t.Fatalf("expected length of followers is 2, but got %d", len(followers))
+	}
+
+	ccs, err := client.GetTicketEmailCCs(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to get ticket email CCs: %s", err)
+	}
+	if len(ccs) != 2 {
+		t.Fatalf("expected length of email CCs is 2, but got %d", len(ccs))
+	}
+}
+
+func TestSetTicketCollaborators(t *testing.T) {
+	type setter func(*Client, context.Context, int64, []int64) (Ticket, error)
+
+	cases := []struct {
+		set      setter
+		userIDs  []int64
+		expected string
+	}{
+		{(*Client).SetTicketCollaborators, []int64{562, 624}, `{"ticket":{"collaborator_ids":[562,624]}}`},
+		{(*Client).SetTicketFollowers, []int64{562}, `{"ticket":{"follower_ids":[562]}}`},
+		{(*Client).SetTicketEmailCCs, []int64{624}, `{"ticket":{"email_cc_ids":[624]}}`},
+		{(*Client).SetTicketEmailCCs, []int64{}, `{"ticket":{"email_cc_ids":[]}}`},
+		{(*Client).SetTicketCollaborators, nil, `{"ticket":{"collaborator_ids":[]}}`},
+	}
+
+	for _, c := range cases {
+		mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut || r.URL.Path != "/tickets/2.json" {
+				t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			body, _ := ioutil.ReadAll(r.Body)
+			if string(body) != c.expected {
+				t.Fatalf("request body expect %s, but got %s", c.expected, string(body))
+			}
+			w.Write(readFixture(filepath.Join(http.MethodPut, "ticket.json")))
+		}))
+		client := newTestClient(mockAPI)
+
+		if _, err := c.set(client, ctx, 2, c.userIDs); err != nil {
+			t.Fatalf("Failed to set ticket users: %s", err)
+		}
+		mockAPI.Close()
+	}
+}
@@ -0,0 +1,248 @@
+package zendesk
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ticketPriorityOrder orders ticket priorities for less_than and greater_than conditions
+var ticketPriorityOrder = map[string]int{
+	"low":    1,
+	"normal": 2,
+	"high":   3,
+	"urgent": 4,
+}
+
+// ticketStatusOrder orders ticket statuses for less_than and greater_than conditions
+var ticketStatusOrder = map[string]int{
+	"new":     1,
+	"open":    2,
+	"pending": 3,
+	"hold":    4,
+	"solved":  5,
+	"closed":  6,
+}
+
+// UnsupportedConditionError is returned when a condition can't be evaluated locally
+type UnsupportedConditionError struct {
+	Condition Condition
+}
+
+func (e *UnsupportedConditionError) Error() string {
+	return fmt.Sprintf("unsupported condition: %s %s %q", e.Condition.Field, e.Condition.Operator, e.Condition.Value)
+}
+
+// EvaluateConditions reports whether the ticket meets the conditions.
+// Every condition in All must be met, and at least one condition in Any
+// if Any isn't empty.
+//
+// Supported fields are type, status, priority, group_id, assignee_id,
+// requester_id, submitter_id, organization_id, brand_id, ticket_form_id,
+// current_tags, update_type and custom_fields_<id>. Supported operators are
+// is, is_not, less_than, less_than_equal, greater_than and greater_than_equal
+// (status and priority only), includes and not_includes (current_tags only).
+// Operators about an update, such as changed and value, need the previous state
+// of the ticket and are evaluated by EvaluateConditionsUpdate.
+// Anything else, such as via_id, time based fields of automations or
+// comment_includes_word, returns *UnsupportedConditionError
+func EvaluateConditions(c Conditions, ticket Ticket) (bool, error) {
+	return evaluateConditions(c, false, nil, ticket)
+}
+
+// EvaluateConditionsUpdate reports whether the update from previous to ticket meets
+// the conditions, as a trigger does when the ticket is updated. In addition to the ones
+// of EvaluateConditions, it supports changed, not_changed, value (changed to),
+// not_value, value_previous (changed from) and not_value_previous operators.
+// Pass nil as previous for a ticket creation, where every set field has changed
+func EvaluateConditionsUpdate(c Conditions, previous *Ticket, ticket Ticket) (bool, error) {
+	return evaluateConditions(c, true, previous, ticket)
+}
+
+func evaluateConditions(c Conditions, update bool, previous *Ticket, ticket Ticket) (bool, error) {
+	for _, cond := range c.All {
+		ok, err := evaluateCondition(cond, update, previous, ticket)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	if len(c.Any) == 0 {
+		return true, nil
+	}
+	for _, cond := range c.Any {
+		ok, err := evaluateCondition(cond, update, previous, ticket)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// evaluateCondition evaluates a condition. update tells whether the condition is
+// evaluated for an update, and previous is nil for a creation
+func evaluateCondition(c Condition, update bool, previous *Ticket, ticket Ticket) (bool, error) {
+	unsupported := &UnsupportedConditionError{Condition: c}
+
+	if c.Field == "update_type" {
+		if !update || c.Operator != "is" {
+			return false, unsupported
+		}
+		if previous == nil {
+			return c.Value == "Create", nil
+		}
+		return c.Value == "Change", nil
+	}
+
+	if c.Field == "current_tags" {
+		return evaluateTagsCondition(c, ticket)
+	}
+
+	current, ok := ticketConditionValue(ticket, c.Field)
+	if !ok {
+		return false, unsupported
+	}
+
+	switch c.Operator {
+	case "is":
+		return current == c.Value, nil
+	case "is_not":
+		return current != c.Value, nil
+	case "less_than", "less_than_equal", "greater_than", "greater_than_equal":
+		return compareOrdered(c, current)
+	}
+
+	if !update {
+		return false, unsupported
+	}
+	var before string
+	if previous != nil {
+		before, _ = ticketConditionValue(*previous, c.Field)
+	}
+	switch c.Operator {
+	case "changed":
+		return before != current, nil
+	case "not_changed":
+		return before == current, nil
+	case "value":
+		return before != current && current == c.Value, nil
+	case "not_value":
+		return before != current && current != c.Value, nil
+	case "value_previous":
+		return before != current && before == c.Value, nil
+	case "not_value_previous":
+		return before != current && before != c.Value, nil
+	}
+	return false, unsupported
+}
+
+func evaluateTagsCondition(c Condition, ticket Ticket) (bool, error) {
+	// Value is a space separated list, and includes matches any of them
+	found := false
+	for _, want := range strings.Fields(c.Value) {
+		for _, tag := range ticket.Tags {
+			if tag == want {
+				found = true
+			}
+		}
+	}
+
+	switch c.Operator {
+	case "includes":
+		return found, nil
+	case "not_includes":
+		return !found, nil
+	}
+	return false, &UnsupportedConditionError{Condition: c}
+}
+
+func compareOrdered(c Condition, current string) (bool, error) {
+	var order map[string]int
+	switch c.Field {
+	case "status":
+		order = ticketStatusOrder
+	case "priority":
+		order = ticketPriorityOrder
+	default:
+		return false, &UnsupportedConditionError{Condition: c}
+	}
+
+	target, ok := order[c.Value]
+	if !ok {
+		return false, &UnsupportedConditionError{Condition: c}
+	}
+	v, ok := order[current]
+	if !ok {
+		// A ticket without the value is neither less nor greater than any value
+		return false, nil
+	}
+
+	switch c.Operator {
+	case "less_than":
+		return v < target, nil
+	case "less_than_equal":
+		return v <= target, nil
+	case "greater_than":
+		return v > target, nil
+	default:
+		return v >= target, nil
+	}
+}
+
+// ticketConditionValue returns the value of the ticket field as conditions format it.
+// An unset field is an empty string. It returns false if the field isn't supported
+func ticketConditionValue(ticket Ticket, field string) (string, bool) {
+	switch field {
+	case "type":
+		return ticket.Type, true
+	case "status":
+		return ticket.Status, true
+	case "priority":
+		return ticket.Priority, true
+	case "group_id":
+		return conditionID(ticket.GroupID), true
+	case "assignee_id":
+		return conditionID(ticket.AssigneeID), true
+	case "requester_id":
+		return conditionID(ticket.RequesterID), true
+	case "submitter_id":
+		return conditionID(ticket.SubmitterID), true
+	case "organization_id":
+		return conditionID(ticket.OrganizationID), true
+	case "brand_id":
+		return conditionID(ticket.BrandID), true
+	case "ticket_form_id":
+		return conditionID(ticket.TicketFormID), true
+	}
+
+	if !strings.HasPrefix(field, "custom_fields_") {
+		return "", false
+	}
+	id, err := strconv.ParseInt(strings.TrimPrefix(field, "custom_fields_"), 10, 64)
+	if err != nil {
+		return "", false
+	}
+	cf, ok := ticket.CustomField(id)
+	if !ok || cf.Value == nil {
+		return "", true
+	}
+	switch v := cf.Value.(type) {
+	case string:
+		return v, true
+	case []string:
+		// Multi-select fields can't be compared as a single value
+		return "", false
+	default:
+		return fmt.Sprint(v), true
+	}
+}
+
+func conditionID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.FormatInt(id, 10)
+}
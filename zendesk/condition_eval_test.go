@@ -0,0 +1,152 @@
+package zendesk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEvaluateConditions(t *testing.T) {
+	ticket := Ticket{
+		Status:   "open",
+		Priority: "high",
+		GroupID:  360004077472,
+		Tags:     []string{"vip"},
+	}
+
+	cases := []struct {
+		name       string
+		conditions Conditions
+		expected   bool
+	}{
+		{
+			name:       "empty",
+			conditions: Conditions{},
+			expected:   true,
+		},
+		{
+			name: "all met",
+			conditions: NewConditions().
+				All("status", "less_than", "solved").
+				All("group_id", "is", "360004077472").
+				Build(),
+			expected: true,
+		},
+		{
+			name: "all not met",
+			conditions: NewConditions().
+				All("status", "is", "open").
+				All("priority", "is_not", "high").
+				Build(),
+			expected: false,
+		},
+		{
+			name: "any met",
+			conditions: NewConditions().
+				Any("priority", "greater_than", "high").
+				Any("current_tags", "includes", "enterprise vip").
+				Build(),
+			expected: true,
+		},
+		{
+			name: "any not met",
+			conditions: NewConditions().
+				Any("priority", "greater_than", "high").
+				Any("assignee_id", "is_not", "").
+				Build(),
+			expected: false,
+		},
+		{
+			name: "all met but any not met",
+			conditions: NewConditions().
+				All("status", "is", "open").
+				Any("current_tags", "not_includes", "vip").
+				Build(),
+			expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ok, err := EvaluateConditions(c.conditions, ticket)
+			if err != nil {
+				t.Fatalf("Failed to evaluate conditions: %s", err)
+			}
+			if ok != c.expected {
+				t.Fatalf("expected %v, but got %v", c.expected, ok)
+			}
+		})
+	}
+}
+
+func TestEvaluateConditionsUpdate(t *testing.T) {
+	previous := Ticket{ID: 2, Status: "new", Priority: "normal"}
+	ticket := Ticket{ID: 2, Status: "open", Priority: "normal"}
+
+	cases := []struct {
+		name       string
+		conditions Conditions
+		expected   bool
+	}{
+		{
+			name:       "changed",
+			conditions: NewConditions().All("status", "changed", "").Build(),
+			expected:   true,
+		},
+		{
+			name:       "not changed",
+			conditions: NewConditions().All("priority", "changed", "").Build(),
+			expected:   false,
+		},
+		{
+			name: "changed to and from",
+			conditions: NewConditions().
+				All("status", "value", "open").
+				All("status", "value_previous", "new").
+				All("update_type", "is", "Change").
+				Build(),
+			expected: true,
+		},
+		{
+			name:       "changed to other value",
+			conditions: NewConditions().All("status", "value", "solved").Build(),
+			expected:   false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ok, err := EvaluateConditionsUpdate(c.conditions, &previous, ticket)
+			if err != nil {
+				t.Fatalf("Failed to evaluate conditions: %s", err)
+			}
+			if ok != c.expected {
+				t.Fatalf("expected %v, but got %v", c.expected, ok)
+			}
+		})
+	}
+
+	created := NewConditions().All("update_type", "is", "Create").All("status", "changed", "").Build()
+	ok, err := EvaluateConditionsUpdate(created, nil, ticket)
+	if err != nil {
+		t.Fatalf("Failed to evaluate conditions: %s", err)
+	}
+	if !ok {
+		t.Fatal("expected conditions to be met by a creation")
+	}
+}
+
+func TestEvaluateConditionsUnsupported(t *testing.T) {
+	cases := []Conditions{
+		NewConditions().All("via_id", "is", "4").Build(),
+		NewConditions().All("status", "changed", "").Build(),
+		NewConditions().All("type", "less_than", "task").Build(),
+	}
+
+	for _, c := range cases {
+		_, err := EvaluateConditions(c, Ticket{})
+		var unsupported *UnsupportedConditionError
+		if !errors.As(err, &unsupported) {
+			t.Fatalf("expected UnsupportedConditionError for %v, but got %v", c, err)
+		}
+	}
+}
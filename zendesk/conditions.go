@@ -0,0 +1,52 @@
+package zendesk
+
+// Condition is a condition of views, triggers and automations
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/views#conditions
+type Condition struct {
+	Field    string `json:"field,omitempty"`
+	Operator string `json:"operator,omitempty"`
+	Value    string `json:"value,omitempty"`
+}
+
+// Conditions is a set of conditions. Every condition in All must be met
+// and at least one condition in Any must be met
+type Conditions struct {
+	All []Condition `json:"all,omitempty"`
+	Any []Condition `json:"any,omitempty"`
+}
+
+// ConditionsBuilder is a fluent builder of Conditions
+type ConditionsBuilder struct {
+	conditions Conditions
+}
+
+// NewConditions creates ConditionsBuilder and returns its pointer
+func NewConditions() *ConditionsBuilder {
+	return &ConditionsBuilder{}
+}
+
+// All adds a condition which must be met
+func (b *ConditionsBuilder) All(field string, operator string, value string) *ConditionsBuilder {
+	b.conditions.All = append(b.conditions.All, Condition{
+		Field:    field,
+		Operator: operator,
+		Value:    value,
+	})
+	return b
+}
+
+// Any adds a condition of which at least one must be met
+func (b *ConditionsBuilder) Any(field string, operator string, value string) *ConditionsBuilder {
+	b.conditions.Any = append(b.conditions.Any, Condition{
+		Field:    field,
+		Operator: operator,
+		Value:    value,
+	})
+	return b
+}
+
+// Build returns the built Conditions
+func (b *ConditionsBuilder) Build() Conditions {
+	return b.conditions
+}
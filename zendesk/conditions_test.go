@@ -0,0 +1,48 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestConditionsBuilder(t *testing.T) {
+	conditions := NewConditions().
+		All("status", "less_than", "solved").
+		All("group_id", "is", "360004077472").
+		Any("priority", "is", "urgent").
+		Build()
+
+	b, err := json.Marshal(conditions)
+	if err != nil {
+		t.Fatalf("Failed to marshal conditions: %s", err)
+	}
+
+	expected := `{"all":[{"field":"status","operator":"less_than","value":"solved"},{"field":"group_id","operator":"is","value":"360004077472"}],"any":[{"field":"priority","operator":"is","value":"urgent"}]}`
+	if string(b) != expected {
+		t.Fatalf("\nExpect:\t%s\nGot:\t%s", expected, b)
+	}
+}
+
+func TestConditionsBuilderOmitsEmptyAny(t *testing.T) {
+	b, err := json.Marshal(NewConditions().All("status", "is", "open").Build())
+	if err != nil {
+		t.Fatalf("Failed to marshal conditions: %s", err)
+	}
+
+	expected := `{"all":[{"field":"status","operator":"is","value":"open"}]}`
+	if string(b) != expected {
+		t.Fatalf("\nExpect:\t%s\nGot:\t%s", expected, b)
+	}
+}
+
+func TestConditionOmitsEmptyValue(t *testing.T) {
+	b, err := json.Marshal(Condition{Field: "assignee_id", Operator: "is"})
+	if err != nil {
+		t.Fatalf("Failed to marshal condition: %s", err)
+	}
+
+	expected := `{"field":"assignee_id","operator":"is"}`
+	if string(b) != expected {
+		t.Fatalf("\nExpect:\t%s\nGot:\t%s", expected, b)
+	}
+}
@@ -1,5 +1,7 @@
 package zendesk
 
+import "net/http"
+
 // Credential is interface of API credential
 type Credential interface {
 	Email() string
@@ -53,3 +55,41 @@ func (c APITokenCredential) Email() string {
 func (c APITokenCredential) Secret() string {
 	return c.apiToken
 }
+
+// Authenticator is interface of authentication scheme which sets
+// the authentication information to each API request
+type Authenticator interface {
+	Authenticate(req *http.Request)
+}
+
+// Authenticate sets Basic authentication header to the request
+func (c BasicAuthCredential) Authenticate(req *http.Request) {
+	req.SetBasicAuth(c.Email(), c.Secret())
+}
+
+// Authenticate sets Basic authentication header with API token to the request
+func (c APITokenCredential) Authenticate(req *http.Request) {
+	req.SetBasicAuth(c.Email(), c.Secret())
+}
+
+// OAuthTokenCredential is type of credential for OAuth access token authentication
+type OAuthTokenCredential struct {
+	accessToken string
+}
+
+// NewOAuthTokenCredential creates OAuthTokenCredential and returns its pointer
+func NewOAuthTokenCredential(accessToken string) *OAuthTokenCredential {
+	return &OAuthTokenCredential{
+		accessToken: accessToken,
+	}
+}
+
+// AccessToken is accessor which returns OAuth access token
+func (c OAuthTokenCredential) AccessToken() string {
+	return c.accessToken
+}
+
+// Authenticate sets Bearer authorization header to the request
+func (c OAuthTokenCredential) Authenticate(req *http.Request) {
+	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+}
@@ -23,3 +23,11 @@ func TestNewAPITokenCredential(t *testing.T) {
 		t.Fatalf("APITokenCredential: secret not match")
 	}
 }
+
+func TestNewOAuthTokenCredential(t *testing.T) {
+	cred := NewOAuthTokenCredential("accesstoken")
+
+	if cred.AccessToken() != "accesstoken" {
+		t.Fatalf("OAuthTokenCredential: access token not match")
+	}
+}
@@ -1,5 +1,11 @@
 package zendesk
 
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
 // CustomFieldOption is struct for value of `custom_field_options`
 type CustomFieldOption struct {
 	ID       int64  `json:"id,omitempty"`
@@ -9,3 +15,80 @@ type CustomFieldOption struct {
 	URL      string `json:"url,omitempty"`
 	Value    string `json:"value"`
 }
+
+// ErrCustomFieldOptionNotFound is returned by ResolveCustomFieldValue when the field has no option of the value
+var ErrCustomFieldOptionNotFound = errors.New("custom field option not found")
+
+// fieldOptionCache caches names of custom field options by field id and value.
+// It's shared by copies of the client and safe for concurrent use
+type fieldOptionCache struct {
+	mu     sync.Mutex
+	fields map[int64]map[string]string
+}
+
+func newFieldOptionCache() *fieldOptionCache {
+	return &fieldOptionCache{fields: map[int64]map[string]string{}}
+}
+
+func (c *fieldOptionCache) lookup(fieldID int64, value string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	name, ok := c.fields[fieldID][value]
+	return name, ok
+}
+
+func (c *fieldOptionCache) store(fieldID int64, options []CustomFieldOption) {
+	if c == nil {
+		return
+	}
+	names := make(map[string]string, len(options))
+	for _, o := range options {
+		names[o.Value] = o.Name
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.fields[fieldID] = names
+}
+
+func (c *fieldOptionCache) invalidate(fieldID int64) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.fields, fieldID)
+}
+
+// ResolveCustomFieldValue returns the name of the option whose value (tag) is value,
+// e.g. the label of a dropdown field value on a ticket.
+// Options are cached per field by clients created with NewClient, and fetched again
+// when the value isn't in the cached ones, so that newly added options are found.
+// It returns ErrCustomFieldOptionNotFound if the field has no such option
+func (z *Client) ResolveCustomFieldValue(ctx context.Context, fieldID int64, value string) (string, error) {
+	return resolveCustomFieldValue(ctx, z, z.fieldOptions, fieldID, value)
+}
+
+// resolveCustomFieldValue resolves the value with options cached in cache or fetched by api
+func resolveCustomFieldValue(ctx context.Context, api TicketFieldAPI, cache *fieldOptionCache, fieldID int64, value string) (string, error) {
+	if name, ok := cache.lookup(fieldID, value); ok {
+		return name, nil
+	}
+
+	field, err := api.GetTicketField(ctx, fieldID)
+	if err != nil {
+		return "", err
+	}
+	cache.store(fieldID, field.CustomFieldOptions)
+
+	for _, o := range field.CustomFieldOptions {
+		if o.Value == value {
+			return o.Name, nil
+		}
+	}
+	return "", ErrCustomFieldOptionNotFound
+}
@@ -0,0 +1,70 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const dropdownFieldJSON = `{"ticket_field":{"id":360005657120,"type":"tagger","title":"Plan","custom_field_options":[
+	{"id":1,"name":"Gold Plan","value":"plan_gold"},
+	{"id":2,"name":"Silver Plan","value":"plan_silver"}
+]}}`
+
+func TestResolveCustomFieldValue(t *testing.T) {
+	var requests int
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ticket_fields/360005657120.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		requests++
+		w.Write([]byte(dropdownFieldJSON))
+	}))
+	defer mockAPI.Close()
+
+	client, _ := NewClient(nil)
+	client.SetEndpointURL(mockAPI.URL)
+	client.SetCredential(NewAPITokenCredential("", ""))
+
+	for _, c := range []struct{ value, name string }{
+		{"plan_gold", "Gold Plan"},
+		{"plan_silver", "Silver Plan"},
+	} {
+		name, err := client.ResolveCustomFieldValue(ctx, 360005657120, c.value)
+		if err != nil {
+			t.Fatalf("Failed to resolve custom field value: %s", err)
+		}
+		if name != c.name {
+			t.Fatalf("name of %s expect %s, but got %s", c.value, c.name, name)
+		}
+	}
+	if requests != 1 {
+		t.Fatalf("expected options to be fetched once, but got %d requests", requests)
+	}
+}
+
+func TestResolveCustomFieldValueNotFound(t *testing.T) {
+	var requests int
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(dropdownFieldJSON))
+	}))
+	defer mockAPI.Close()
+
+	client, _ := NewClient(nil)
+	client.SetEndpointURL(mockAPI.URL)
+	client.SetCredential(NewAPITokenCredential("", ""))
+
+	if _, err := client.ResolveCustomFieldValue(ctx, 360005657120, "plan_gold"); err != nil {
+		t.Fatalf("Failed to resolve custom field value: %s", err)
+	}
+
+	_, err := client.ResolveCustomFieldValue(ctx, 360005657120, "plan_bronze")
+	if err != ErrCustomFieldOptionNotFound {
+		t.Fatalf("expected ErrCustomFieldOptionNotFound, but got %v", err)
+	}
+	// An unknown value refreshes the cached options in case the option was added
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, but got %d", requests)
+	}
+}
@@ -0,0 +1,150 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Status categories of CustomStatus. Every custom status belongs to one of them,
+// and the status of a ticket follows the category of its custom status
+const (
+	StatusCategoryNew     = "new"
+	StatusCategoryOpen    = "open"
+	StatusCategoryPending = "pending"
+	StatusCategoryHold    = "hold"
+	StatusCategorySolved  = "solved"
+)
+
+// CustomStatus is struct for custom ticket status payload
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/
+type CustomStatus struct {
+	ID                 int64     `json:"id,omitempty"`
+	StatusCategory     string    `json:"status_category,omitempty"`
+	AgentLabel         string    `json:"agent_label,omitempty"`
+	EndUserLabel       string    `json:"end_user_label,omitempty"`
+	Description        string    `json:"description,omitempty"`
+	EndUserDescription string    `json:"end_user_description,omitempty"`
+	Active             bool      `json:"active"`
+	Default            bool      `json:"default,omitempty"`
+	CreatedAt          time.Time `json:"created_at,omitempty"`
+	UpdatedAt          time.Time `json:"updated_at,omitempty"`
+}
+
+// CustomStatusListOptions is options for GetCustomStatuses
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/#list-custom-ticket-statuses
+type CustomStatusListOptions struct {
+	// StatusCategories filters statuses by comma separated categories such as "open,pending"
+	StatusCategories string `url:"status_categories,omitempty"`
+
+	// Active filters statuses by active or inactive
+	Active *bool `url:"active,omitempty"`
+
+	// Default filters statuses by default or not
+	Default *bool `url:"default,omitempty"`
+}
+
+// CustomStatusAPI an interface containing custom ticket status related methods
+type CustomStatusAPI interface {
+	GetCustomStatuses(ctx context.Context, opts *CustomStatusListOptions) ([]CustomStatus, error)
+	GetCustomStatus(ctx context.Context, id int64) (CustomStatus, error)
+	CreateCustomStatus(ctx context.Context, status CustomStatus) (CustomStatus, error)
+	UpdateCustomStatus(ctx context.Context, id int64, status CustomStatus) (CustomStatus, error)
+}
+
+// GetCustomStatuses fetches custom ticket statuses
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/#list-custom-ticket-statuses
+func (z *Client) GetCustomStatuses(ctx context.Context, opts *CustomStatusListOptions) ([]CustomStatus, error) {
+	var data struct {
+		CustomStatuses []CustomStatus `json:"custom_statuses"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CustomStatusListOptions{}
+	}
+
+	u, err := addOptions("/custom_statuses.json", tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, err
+	}
+	return data.CustomStatuses, nil
+}
+
+// GetCustomStatus fetches a custom ticket status
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/#show-custom-ticket-status
+func (z *Client) GetCustomStatus(ctx context.Context, id int64) (CustomStatus, error) {
+	var result struct {
+		CustomStatus CustomStatus `json:"custom_status"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/custom_statuses/%d.json", id))
+	if err != nil {
+		return CustomStatus{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomStatus{}, err
+	}
+	return result.CustomStatus, nil
+}
+
+// CreateCustomStatus creates a custom ticket status.
+// StatusCategory and AgentLabel are required
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/#create-custom-ticket-status
+func (z *Client) CreateCustomStatus(ctx context.Context, status CustomStatus) (CustomStatus, error) {
+	var data, result struct {
+		CustomStatus CustomStatus `json:"custom_status"`
+	}
+	data.CustomStatus = status
+
+	body, err := z.post(ctx, "/custom_statuses.json", data)
+	if err != nil {
+		return CustomStatus{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomStatus{}, err
+	}
+	return result.CustomStatus, nil
+}
+
+// UpdateCustomStatus updates a custom ticket status.
+// The status category of an existing status can't be changed
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/tickets/custom_ticket_statuses/#update-custom-ticket-status
+func (z *Client) UpdateCustomStatus(ctx context.Context, id int64, status CustomStatus) (CustomStatus, error) {
+	var data, result struct {
+		CustomStatus CustomStatus `json:"custom_status"`
+	}
+	data.CustomStatus = status
+
+	body, err := z.put(ctx, fmt.Sprintf("/custom_statuses/%d.json", id), data)
+	if err != nil {
+		return CustomStatus{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return CustomStatus{}, err
+	}
+	return result.CustomStatus, nil
+}
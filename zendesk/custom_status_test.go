@@ -0,0 +1,84 @@
+package zendesk
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetCustomStatuses(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "custom_statuses.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	statuses, err := client.GetCustomStatuses(ctx, &CustomStatusListOptions{StatusCategories: "open,pending"})
+	if err != nil {
+		t.Fatalf("Failed to get custom statuses: %s", err)
+	}
+
+	if len(statuses) != 2 {
+		t.Fatalf("expected length of custom statuses is 2, but got %d", len(statuses))
+	}
+	if statuses[1].StatusCategory != StatusCategoryPending {
+		t.Fatalf("status category expect %s, but got %s", StatusCategoryPending, statuses[1].StatusCategory)
+	}
+}
+
+func TestGetCustomStatus(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "custom_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	status, err := client.GetCustomStatus(ctx, 35437)
+	if err != nil {
+		t.Fatalf("Failed to get custom status: %s", err)
+	}
+
+	if status.ID != 35437 || status.AgentLabel != "Waiting on vendor" || !status.Active {
+		t.Fatalf("custom status has unexpected fields %v", status)
+	}
+}
+
+func TestCreateCustomStatus(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "custom_status.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateCustomStatus(ctx, CustomStatus{
+		StatusCategory: StatusCategoryPending,
+		AgentLabel:     "Waiting on vendor",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create custom status: %s", err)
+	}
+}
+
+func TestUpdateCustomStatus(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "custom_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateCustomStatus(ctx, 35437, CustomStatus{AgentLabel: "Waiting on vendor", Active: true})
+	if err != nil {
+		t.Fatalf("Failed to update custom status: %s", err)
+	}
+}
+
+func TestUpdateTicketCustomStatus(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		expected := `{"ticket":{"custom_status_id":35437}}`
+		if string(body) != expected {
+			t.Fatalf("request body expect %s, but got %s", expected, string(body))
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.UpdateTicket(ctx, 2, TicketUpdate{CustomStatusID: Int64(35437)}); err != nil {
+		t.Fatalf("Failed to update ticket: %s", err)
+	}
+}
@@ -0,0 +1,189 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DeletedTicketRetention is how long a deleted ticket stays recoverable.
+// Zendesk scrubs deleted tickets permanently after that
+const DeletedTicketRetention = 30 * 24 * time.Hour
+
+// ErrDeletedTicketNotFound is returned by GetDeletedTicket when the ticket is not in the deleted tickets
+var ErrDeletedTicketNotFound = errors.New("deleted ticket not found")
+
+// DeletedTicket is struct for a ticket in the deleted tickets list
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#list-deleted-tickets
+type DeletedTicket struct {
+	ID          int64  `json:"id"`
+	Subject     string `json:"subject"`
+	Description string `json:"description"`
+	Actor       struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"actor"`
+	PreviousState string     `json:"previous_state"`
+	DeletedAt     *time.Time `json:"deleted_at"`
+}
+
+// RecoverableUntil returns when the deleted ticket is permanently removed
+func (t DeletedTicket) RecoverableUntil() time.Time {
+	if t.DeletedAt == nil {
+		return time.Time{}
+	}
+	return t.DeletedAt.Add(DeletedTicketRetention)
+}
+
+// DeletedTicketListOptions is options for GetDeletedTickets
+type DeletedTicketListOptions struct {
+	PageOptions
+
+	// SortBy can take "id", "subject" or "deleted_at"
+	SortBy string `url:"sort_by,omitempty"`
+
+	// SortOrder can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// DeletedTicketAPI an interface containing ticket deletion and recovery methods
+type DeletedTicketAPI interface {
+	DeleteTicket(ctx context.Context, ticketID int64) error
+	GetDeletedTickets(ctx context.Context, opts *DeletedTicketListOptions) ([]DeletedTicket, Page, error)
+	GetDeletedTicketsCursor(ctx context.Context, opts *CursorOptions) ([]DeletedTicket, CursorMeta, error)
+	EachDeletedTicket(ctx context.Context, fn func(DeletedTicket) error) error
+	GetDeletedTicket(ctx context.Context, ticketID int64) (DeletedTicket, error)
+	RestoreDeletedTicket(ctx context.Context, ticketID int64) error
+}
+
+// DeleteTicket deletes the ticket. The ticket is moved to the deleted tickets
+// and can be restored within DeletedTicketRetention
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#delete-ticket
+func (z *Client) DeleteTicket(ctx context.Context, ticketID int64) error {
+	return z.delete(ctx, fmt.Sprintf("/tickets/%d.json", ticketID))
+}
+
+// GetDeletedTickets fetches deleted tickets which are still recoverable
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#list-deleted-tickets
+func (z *Client) GetDeletedTickets(ctx context.Context, opts *DeletedTicketListOptions) ([]DeletedTicket, Page, error) {
+	var data struct {
+		DeletedTickets []DeletedTicket `json:"deleted_tickets"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &DeletedTicketListOptions{}
+	}
+
+	u, err := addOptions("/deleted_tickets.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.DeletedTickets, data.Page, nil
+}
+
+// GetDeletedTicketsCursor fetches a page of deleted tickets with cursor based pagination,
+// which has no limit of offset unlike GetDeletedTickets. Pass AfterCursor of the returned
+// meta as PageAfter to get the next page while HasMore is true
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#list-deleted-tickets
+func (z *Client) GetDeletedTicketsCursor(ctx context.Context, opts *CursorOptions) ([]DeletedTicket, CursorMeta, error) {
+	var data struct {
+		DeletedTickets []DeletedTicket `json:"deleted_tickets"`
+		Meta           CursorMeta      `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &CursorOptions{}
+	}
+
+	u, err := addOptions("/deleted_tickets.json", tmp)
+	if err != nil {
+		return nil, CursorMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, CursorMeta{}, err
+	}
+	return data.DeletedTickets, data.Meta, nil
+}
+
+// EachDeletedTicket calls fn for every deleted ticket, following the cursors of
+// GetDeletedTicketsCursor until the last page.
+// Iteration stops at the first error returned by fn, and the error is returned
+func (z *Client) EachDeletedTicket(ctx context.Context, fn func(DeletedTicket) error) error {
+	opts := &CursorOptions{PageSize: MaxPerPage}
+	for {
+		tickets, meta, err := z.GetDeletedTicketsCursor(ctx, opts)
+		if err != nil {
+			return err
+		}
+		for _, t := range tickets {
+			if err := fn(t); err != nil {
+				return err
+			}
+		}
+
+		if !meta.HasMore || meta.AfterCursor == "" || meta.AfterCursor == opts.PageAfter {
+			return nil
+		}
+		opts.PageAfter = meta.AfterCursor
+	}
+}
+
+// errFound stops EachDeletedTicket when the ticket is found
+var errFound = errors.New("found")
+
+// GetDeletedTicket finds the ticket in the deleted tickets.
+// There is no endpoint to show a single deleted ticket, so it walks
+// EachDeletedTicket. ErrDeletedTicketNotFound is returned if the ticket
+// isn't deleted or isn't recoverable any more
+func (z *Client) GetDeletedTicket(ctx context.Context, ticketID int64) (DeletedTicket, error) {
+	var found DeletedTicket
+	err := z.EachDeletedTicket(ctx, func(t DeletedTicket) error {
+		if t.ID != ticketID {
+			return nil
+		}
+		found = t
+		return errFound
+	})
+	switch err {
+	case errFound:
+		return found, nil
+	case nil:
+		return DeletedTicket{}, ErrDeletedTicketNotFound
+	default:
+		return DeletedTicket{}, err
+	}
+}
+
+// RestoreDeletedTicket restores the deleted ticket
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#restore-a-previously-deleted-ticket
+func (z *Client) RestoreDeletedTicket(ctx context.Context, ticketID int64) error {
+	_, err := z.put(ctx, fmt.Sprintf("/deleted_tickets/%d/restore.json", ticketID), nil)
+	return err
+}
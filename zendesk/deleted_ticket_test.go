@@ -0,0 +1,114 @@
+package zendesk
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDeleteTicketThenGetDeletedTickets(t *testing.T) {
+	deleted := false
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodDelete && r.URL.Path == "/tickets/35436.json":
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/deleted_tickets.json":
+			tickets := `{"id":20057623,"subject":"Something else","actor":{"id":3946,"name":"Taylor Agent"},"previous_state":"open","deleted_at":"2020-05-01T09:00:00Z"}`
+			if deleted {
+				tickets += `,{"id":35436,"subject":"Printer is on fire","actor":{"id":3946,"name":"Taylor Agent"},"previous_state":"solved","deleted_at":"2020-05-20T16:33:19Z"}`
+			}
+			fmt.Fprintf(w, `{"deleted_tickets":[%s],"next_page":null,"previous_page":null,"count":2}`, tickets)
+		default:
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.GetDeletedTicket(ctx, 35436); err != ErrDeletedTicketNotFound {
+		t.Fatalf("expected ErrDeletedTicketNotFound before deletion, but got %v", err)
+	}
+
+	if err := client.DeleteTicket(ctx, 35436); err != nil {
+		t.Fatalf("Failed to delete ticket: %s", err)
+	}
+
+	tickets, _, err := client.GetDeletedTickets(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get deleted tickets: %s", err)
+	}
+	if len(tickets) != 2 {
+		t.Fatalf("expected length of deleted tickets is 2, but got %d", len(tickets))
+	}
+
+	ticket, err := client.GetDeletedTicket(ctx, 35436)
+	if err != nil {
+		t.Fatalf("Failed to get deleted ticket: %s", err)
+	}
+	if ticket.PreviousState != "solved" || ticket.Actor.Name != "Taylor Agent" {
+		t.Fatalf("Deleted ticket was not parsed as expected: %v", ticket)
+	}
+
+	expected := time.Date(2020, 6, 19, 16, 33, 19, 0, time.UTC)
+	if until := ticket.RecoverableUntil(); !until.Equal(expected) {
+		t.Fatalf("recoverable until expect %s, but got %s", expected, until)
+	}
+}
+
+func TestRestoreDeletedTicket(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/deleted_tickets/35436/restore.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.RestoreDeletedTicket(ctx, 35436); err != nil {
+		t.Fatalf("Failed to restore deleted ticket: %s", err)
+	}
+}
+
+func TestEachDeletedTicket(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if size := r.URL.Query().Get("page[size]"); size != "100" {
+			t.Fatalf("page[size] expect 100, but got %s", size)
+		}
+		switch r.URL.Query().Get("page[after]") {
+		case "":
+			w.Write([]byte(`{"deleted_tickets":[{"id":1},{"id":2}],"meta":{"has_more":true,"after_cursor":"xxx"}}`))
+		case "xxx":
+			w.Write([]byte(`{"deleted_tickets":[{"id":3},{"id":4}],"meta":{"has_more":true,"after_cursor":"yyy"}}`))
+		case "yyy":
+			w.Write([]byte(`{"deleted_tickets":[{"id":5}],"meta":{"has_more":false,"after_cursor":"zzz"}}`))
+		default:
+			t.Fatalf("Unexpected cursor %s", r.URL.Query().Get("page[after]"))
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var ids []int64
+	err := client.EachDeletedTicket(ctx, func(ticket DeletedTicket) error {
+		ids = append(ids, ticket.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Failed to walk deleted tickets: %s", err)
+	}
+
+	if len(ids) != 5 || ids[0] != 1 || ids[4] != 5 {
+		t.Fatalf("expected deleted tickets 1 to 5, but got %v", ids)
+	}
+
+	ticket, err := client.GetDeletedTicket(ctx, 4)
+	if err != nil {
+		t.Fatalf("Failed to get deleted ticket: %s", err)
+	}
+	if ticket.ID != 4 {
+		t.Fatalf("expected deleted ticket 4, but got %d", ticket.ID)
+	}
+}
@@ -0,0 +1,139 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestDryRun(t *testing.T) {
+	reads := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Fatalf("%s %s was sent in dry-run mode", r.Method, r.URL.Path)
+		}
+		reads++
+		w.Write(readFixture(filepath.Join(http.MethodGet, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	log := client.EnableDryRun()
+
+	ticket, err := client.GetTicket(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to get ticket: %s", err)
+	}
+	if reads != 1 {
+		t.Fatalf("expected GET to be sent, but got %d reads", reads)
+	}
+
+	created, err := client.CreateTicket(ctx, Ticket{Subject: "Migrated ticket"})
+	if err != nil {
+		t.Fatalf("Failed to create ticket: %s", err)
+	}
+	if created.Subject != "Migrated ticket" {
+		t.Fatalf("expected the request to be echoed back, but got subject %q", created.Subject)
+	}
+
+	if _, err := client.UpdateTicket(ctx, ticket.ID, TicketUpdate{Status: String("solved")}); err != nil {
+		t.Fatalf("Failed to update ticket: %s", err)
+	}
+	if err := client.DeleteOrganization(ctx, 361898904439); err != nil {
+		t.Fatalf("Failed to delete organization: %s", err)
+	}
+
+	w := client.UploadAttachment(ctx, "notes.txt", "")
+	if _, err := w.Write([]byte("hello")); err != nil {
+		t.Fatalf("Failed to write upload: %s", err)
+	}
+	if _, err := w.Close(); err != nil {
+		t.Fatalf("Failed to upload attachment: %s", err)
+	}
+
+	requests := log.Requests()
+	if len(requests) != 4 {
+		t.Fatalf("expected length of captured requests is 4, but got %d", len(requests))
+	}
+
+	expected := []struct {
+		method, url, body string
+	}{
+		{http.MethodPost, mockAPI.URL + "/tickets.json", `{"ticket":{"subject":"Migrated ticket"}}`},
+		{http.MethodPut, mockAPI.URL + "/tickets/2.json", `{"ticket":{"status":"solved"}}`},
+		{http.MethodDelete, mockAPI.URL + "/organizations/361898904439.json", ""},
+		{http.MethodPost, mockAPI.URL + "/uploads.json?filename=notes.txt", "hello"},
+	}
+	for i, e := range expected {
+		r := requests[i]
+		if r.Method != e.method || r.URL != e.url || string(r.Body) != e.body {
+			t.Fatalf("captured request %d expect %s %s %s, but got %s %s %s", i, e.method, e.url, e.body, r.Method, r.URL, string(r.Body))
+		}
+	}
+
+	client.DisableDryRun()
+	if _, err := client.GetTicket(ctx, 2); err != nil {
+		t.Fatalf("Failed to get ticket: %s", err)
+	}
+	if len(log.Requests()) != 4 {
+		t.Fatalf("expected no more requests to be captured after DisableDryRun")
+	}
+}
+
+func TestDryRunDo(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("%s %s was sent in dry-run mode", r.Method, r.URL.Path)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	log := client.EnableDryRun()
+
+	if _, err := client.CreateTicket(ctx, Ticket{Subject: "Migrated ticket"}); err != nil {
+		t.Fatalf("Failed to create ticket: %s", err)
+	}
+	body, resp, err := client.Do(ctx, http.MethodPost, "/tickets.json", map[string]map[string]string{"ticket": {"subject": "Migrated ticket"}})
+	if err != nil {
+		t.Fatalf("Failed to do request: %s", err)
+	}
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("expected status 201, but got %d", resp.StatusCode)
+	}
+
+	requests := log.Requests()
+	if len(requests) != 2 {
+		t.Fatalf("expected length of captured requests is 2, but got %d", len(requests))
+	}
+	typed, raw := requests[0], requests[1]
+	if typed.Method != raw.Method || typed.URL != raw.URL || string(typed.Body) != string(raw.Body) {
+		t.Fatalf("Do captured %s %s %s, but the typed method captured %s %s %s", raw.Method, raw.URL, string(raw.Body), typed.Method, typed.URL, string(typed.Body))
+	}
+	if string(body) != string(raw.Body) {
+		t.Fatalf("expected the request to be echoed back, but got %s", string(body))
+	}
+}
+
+func TestDryRunSendsReadOnlyPost(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/problems/autocomplete.json" {
+			t.Fatalf("%s %s was sent in dry-run mode", r.Method, r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "problems.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	log := client.EnableDryRun()
+
+	problems, err := client.AutocompleteProblems(ctx, "printer")
+	if err != nil {
+		t.Fatalf("Failed to autocomplete problems: %s", err)
+	}
+	if len(problems) != 2 {
+		t.Fatalf("expected length of problems is 2, but got %d", len(problems))
+	}
+	if len(log.Requests()) != 0 {
+		t.Fatalf("expected a read-only POST not to be captured, but got %v", log.Requests())
+	}
+}
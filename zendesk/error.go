@@ -6,6 +6,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"unicode/utf8"
 )
 
 // Error an error type containing the http response from zendesk
@@ -14,13 +15,29 @@ type Error struct {
 	resp *http.Response
 }
 
-// Error the error string for this error
+// maxErrorBodyLength is the max length of the response body included in the error string
+const maxErrorBodyLength = 512
+
+// Error the error string for this error.
+// It reads like "PUT /api/v2/tickets/123.json: 422: <response body>" when the request is known.
+// Only the method and path of the request are included, so query and headers never leak
 func (e Error) Error() string {
 	msg := string(e.body)
 	if msg == "" {
 		msg = http.StatusText(e.Status())
 	}
+	if len(msg) > maxErrorBodyLength {
+		// Step back to a rune boundary so that a multi-byte character isn't cut
+		n := maxErrorBodyLength
+		for n > 0 && !utf8.RuneStart(msg[n]) {
+			n--
+		}
+		msg = msg[:n] + "..."
+	}
 
+	if req := e.resp.Request; req != nil && req.URL != nil {
+		return fmt.Sprintf("%s %s: %d: %s", req.Method, req.URL.Path, e.resp.StatusCode, msg)
+	}
 	return fmt.Sprintf("%d: %s", e.resp.StatusCode, msg)
 }
 
@@ -39,6 +56,35 @@ func (e Error) Status() int {
 	return e.resp.StatusCode
 }
 
+// ConflictError is returned when an update is rejected because the resource
+// was updated by someone else since the given timestamp
+type ConflictError struct {
+	Err Error
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("conflict: %s", e.Err.Error())
+}
+
+// Unwrap returns the underlying Error
+func (e *ConflictError) Unwrap() error {
+	return e.Err
+}
+
+// AuthError is returned when Zendesk rejects the credential of the client
+type AuthError struct {
+	Err Error
+}
+
+func (e *AuthError) Error() string {
+	return fmt.Sprintf("authentication failed: %s", e.Err.Error())
+}
+
+// Unwrap returns the underlying Error
+func (e *AuthError) Unwrap() error {
+	return e.Err
+}
+
 // OptionsError is an error type for invalid option argument.
 type OptionsError struct {
 	opts interface{}
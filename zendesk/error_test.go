@@ -3,7 +3,10 @@ package zendesk
 import (
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"unicode/utf8"
 )
 
 func TestError_Error(t *testing.T) {
@@ -38,6 +41,54 @@ func TestError_ErrorEmptyBody(t *testing.T) {
 	}
 }
 
+func TestError_ErrorIncludesRequest(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"error":"RecordInvalid","description":"Value: is invalid"}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateTicket(ctx, 123, TicketUpdate{Status: String("bogus")})
+	if err == nil {
+		t.Fatal("expected an error for 422 response")
+	}
+
+	expected := `PUT /tickets/123.json: 422: {"error":"RecordInvalid","description":"Value: is invalid"}`
+	if err.Error() != expected {
+		t.Fatalf("Error %s did not have expected value %s", err.Error(), expected)
+	}
+}
+
+func TestError_ErrorTruncatesBody(t *testing.T) {
+	err := Error{
+		body: []byte(strings.Repeat("x", maxErrorBodyLength+100)),
+		resp: &http.Response{StatusCode: http.StatusInternalServerError},
+	}
+
+	expected := "500: " + strings.Repeat("x", maxErrorBodyLength) + "..."
+	if v := err.Error(); v != expected {
+		t.Fatalf("Error was not truncated as expected: %s", v)
+	}
+}
+
+func TestError_ErrorTruncatesMultiByteBody(t *testing.T) {
+	// "あ" is 3 bytes, so the limit falls in the middle of a character
+	err := Error{
+		body: []byte("x" + strings.Repeat("あ", maxErrorBodyLength)),
+		resp: &http.Response{StatusCode: http.StatusInternalServerError},
+	}
+
+	v := err.Error()
+	if !utf8.ValidString(v) {
+		t.Fatalf("Error has an invalid UTF-8 string: %q", v)
+	}
+	expected := "500: x" + strings.Repeat("あ", (maxErrorBodyLength-1)/3) + "..."
+	if v != expected {
+		t.Fatalf("Error was not truncated at a rune boundary: %s", v)
+	}
+}
+
 func TestError_Headers(t *testing.T) {
 	retryAfter := "Retry-After"
 	resp := &http.Response{
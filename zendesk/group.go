@@ -25,6 +25,8 @@ type GroupAPI interface {
 	CreateGroup(ctx context.Context, group Group) (Group, error)
 	UpdateGroup(ctx context.Context, groupID int64, group Group) (Group, error)
 	DeleteGroup(ctx context.Context, groupID int64) error
+	GetAssignableGroups(ctx context.Context, opts *PageOptions) ([]Group, Page, error)
+	GetAssignableGroupsForTicket(ctx context.Context, ticketID int64) ([]Group, error)
 }
 
 // GetGroups fetches group list
@@ -121,3 +123,70 @@ func (z *Client) DeleteGroup(ctx context.Context, groupID int64) error {
 
 	return nil
 }
+
+// GetAssignableGroups fetches groups which tickets can be assigned to
+// ref: https://developer.zendesk.com/rest_api/docs/support/groups#list-assignable-groups
+func (z *Client) GetAssignableGroups(ctx context.Context, opts *PageOptions) ([]Group, Page, error) {
+	var data struct {
+		Groups []Group `json:"groups"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	u, err := addOptions("/groups/assignable.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Groups, data.Page, nil
+}
+
+// GetAssignableGroupsForTicket fetches every assignable group of the account
+// except deleted ones, and lists the current group of the ticket first,
+// e.g. for an assignment picker.
+// Groups are not filtered by the brand or the form of the ticket
+func (z *Client) GetAssignableGroupsForTicket(ctx context.Context, ticketID int64) ([]Group, error) {
+	ticket, err := z.GetTicket(ctx, ticketID)
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []Group
+	opts := &PageOptions{PerPage: MaxPerPage}
+	for {
+		page, p, err := z.GetAssignableGroups(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range page {
+			if group.Deleted {
+				continue
+			}
+			if group.ID == ticket.GroupID {
+				groups = append([]Group{group}, groups...)
+				continue
+			}
+			groups = append(groups, group)
+		}
+		next, ok := p.NextPageNumber()
+		if !ok {
+			break
+		}
+		opts.Page = next
+	}
+
+	return groups, nil
+}
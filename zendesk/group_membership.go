@@ -0,0 +1,146 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrNoDefaultGroup is returned by GetUserDefaultGroup when the user has no default group
+var ErrNoDefaultGroup = errors.New("user has no default group")
+
+// GroupMembership is struct for group membership payload.
+// Default is true for the group which new tickets of the agent are assigned to
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/group_memberships
+type GroupMembership struct {
+	ID        int64     `json:"id,omitempty"`
+	URL       string    `json:"url,omitempty"`
+	UserID    int64     `json:"user_id"`
+	GroupID   int64     `json:"group_id"`
+	Default   bool      `json:"default"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// GroupMembershipAPI an interface containing group membership related methods
+type GroupMembershipAPI interface {
+	GetAssignableGroupMemberships(ctx context.Context, opts *PageOptions) ([]GroupMembership, Page, error)
+	GetUserGroupMemberships(ctx context.Context, userID int64) ([]GroupMembership, error)
+	SetDefaultGroupMembership(ctx context.Context, userID, membershipID int64) ([]GroupMembership, error)
+	GetUserDefaultGroup(ctx context.Context, userID int64) (Group, error)
+}
+
+// GetAssignableGroupMemberships fetches memberships of groups which tickets can be assigned to
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/group_memberships#list-assignable-memberships
+func (z *Client) GetAssignableGroupMemberships(ctx context.Context, opts *PageOptions) ([]GroupMembership, Page, error) {
+	var data struct {
+		GroupMemberships []GroupMembership `json:"group_memberships"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	u, err := addOptions("/group_memberships/assignable.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.GroupMemberships, data.Page, nil
+}
+
+// GetUserGroupMemberships fetches every group membership of the user
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/group_memberships#list-memberships
+func (z *Client) GetUserGroupMemberships(ctx context.Context, userID int64) ([]GroupMembership, error) {
+	var all []GroupMembership
+	opts := &PageOptions{PerPage: MaxPerPage}
+	for {
+		var data struct {
+			GroupMemberships []GroupMembership `json:"group_memberships"`
+			Page
+		}
+
+		u, err := addOptions(fmt.Sprintf("/users/%d/group_memberships.json", userID), opts)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := z.get(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(body, &data)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, data.GroupMemberships...)
+
+		next, ok := data.Page.NextPageNumber()
+		if !ok {
+			return all, nil
+		}
+		opts.Page = next
+	}
+}
+
+// SetDefaultGroupMembership makes the membership the default of the user.
+// The previous default loses its flag, and all memberships of the user are returned
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/group_memberships#set-membership-as-default
+func (z *Client) SetDefaultGroupMembership(ctx context.Context, userID, membershipID int64) ([]GroupMembership, error) {
+	var result struct {
+		GroupMemberships []GroupMembership `json:"group_memberships"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/users/%d/group_memberships/%d/make_default.json", userID, membershipID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.GroupMemberships, nil
+}
+
+// GetUserDefaultGroup fetches the default group of the user.
+// It returns ErrNoDefaultGroup if no membership of the user is the default
+func (z *Client) GetUserDefaultGroup(ctx context.Context, userID int64) (Group, error) {
+	return getUserDefaultGroup(ctx, z, userID)
+}
+
+// getUserDefaultGroup looks up the default group with memberships and groups fetched by api
+func getUserDefaultGroup(ctx context.Context, api interface {
+	GroupAPI
+	GroupMembershipAPI
+}, userID int64) (Group, error) {
+	memberships, err := api.GetUserGroupMemberships(ctx, userID)
+	if err != nil {
+		return Group{}, err
+	}
+
+	for _, m := range memberships {
+		if m.Default {
+			return api.GetGroup(ctx, m.GroupID)
+		}
+	}
+	return Group{}, ErrNoDefaultGroup
+}
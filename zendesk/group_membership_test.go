@@ -0,0 +1,94 @@
+package zendesk
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetAssignableGroupMemberships(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/group_memberships/assignable.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "group_memberships.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	memberships, _, err := client.GetAssignableGroupMemberships(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get assignable group memberships: %s", err)
+	}
+
+	if len(memberships) != 2 {
+		t.Fatalf("expected length of group memberships is 2, but got %d", len(memberships))
+	}
+}
+
+func TestSetDefaultGroupMembership(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/users/377922500012/group_memberships/360008686233/make_default.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if body, _ := ioutil.ReadAll(r.Body); len(body) != 0 {
+			t.Fatalf("expected no request body, but got %s", string(body))
+		}
+		w.Write([]byte(`{"group_memberships":[
+			{"id":360008686233,"user_id":377922500012,"group_id":360004077472,"default":true},
+			{"id":360008686253,"user_id":377922500012,"group_id":360002440594,"default":false}
+		]}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	memberships, err := client.SetDefaultGroupMembership(ctx, 377922500012, 360008686233)
+	if err != nil {
+		t.Fatalf("Failed to set default group membership: %s", err)
+	}
+
+	if len(memberships) != 2 {
+		t.Fatalf("expected length of group memberships is 2, but got %d", len(memberships))
+	}
+	if !memberships[0].Default || memberships[1].Default {
+		t.Fatalf("expected the default to move to 360008686233, but got %v", memberships)
+	}
+}
+
+func TestGetUserDefaultGroup(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users/377922500012/group_memberships.json":
+			w.Write(readFixture(filepath.Join(http.MethodGet, "group_memberships.json")))
+		case "/groups/360002440594.json":
+			w.Write(readFixture(filepath.Join(http.MethodGet, "group.json")))
+		default:
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	group, err := client.GetUserDefaultGroup(ctx, 377922500012)
+	if err != nil {
+		t.Fatalf("Failed to get default group of user: %s", err)
+	}
+
+	if group.ID != 360002440594 {
+		t.Fatalf("expected group id 360002440594, but got %d", group.ID)
+	}
+}
+
+func TestGetUserDefaultGroupWithoutDefault(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"group_memberships":[{"id":1,"user_id":2,"group_id":3,"default":false}],"next_page":null}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.GetUserDefaultGroup(ctx, 2); err != ErrNoDefaultGroup {
+		t.Fatalf("expected ErrNoDefaultGroup, but got %v", err)
+	}
+}
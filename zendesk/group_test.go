@@ -3,6 +3,7 @@ package zendesk
 import (
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -76,3 +77,48 @@ func TestDeleteGroup(t *testing.T) {
 		t.Fatalf("Failed to delete group: %s", err)
 	}
 }
+
+func TestGetAssignableGroups(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "assignable_groups.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	groups, _, err := client.GetAssignableGroups(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get assignable groups: %s", err)
+	}
+
+	if len(groups) != 3 {
+		t.Fatalf("expected length of groups is 3, but got %d", len(groups))
+	}
+}
+
+func TestGetAssignableGroupsForTicket(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tickets/2.json":
+			w.Write(readFixture(filepath.Join(http.MethodGet, "ticket.json")))
+		case "/groups/assignable.json":
+			w.Write(readFixture(filepath.Join(http.MethodGet, "assignable_groups.json")))
+		default:
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	groups, err := client.GetAssignableGroupsForTicket(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to get assignable groups for ticket: %s", err)
+	}
+
+	if len(groups) != 2 {
+		t.Fatalf("expected length of groups is 2, but got %d", len(groups))
+	}
+	if groups[0].ID != 360004077472 {
+		t.Fatalf("expected the ticket's group first, but got %d", groups[0].ID)
+	}
+	if groups[1].ID != 360002440594 {
+		t.Fatalf("expected group id 360002440594, but got %d", groups[1].ID)
+	}
+}
@@ -0,0 +1,60 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// JobStatus is struct for job status payload of background jobs such as bulk operations
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/job_statuses#json-format
+type JobStatus struct {
+	ID       string            `json:"id"`
+	URL      string            `json:"url"`
+	Total    int64             `json:"total"`
+	Progress int64             `json:"progress"`
+	Status   string            `json:"status"`
+	Message  string            `json:"message"`
+	Results  []JobStatusResult `json:"results"`
+}
+
+// JobStatusResult is a result of an item processed by the job
+type JobStatusResult struct {
+	ID      int64  `json:"id"`
+	Index   int64  `json:"index"`
+	Action  string `json:"action"`
+	Success bool   `json:"success"`
+	Status  string `json:"status"`
+	Error   string `json:"error"`
+	Details string `json:"details"`
+}
+
+// JobStatusAPI an interface containing all job status related methods
+type JobStatusAPI interface {
+	GetJobStatus(ctx context.Context, id string) (JobStatus, error)
+}
+
+// GetJobStatus gets a specified job status
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/job_statuses#show-job-status
+func (z *Client) GetJobStatus(ctx context.Context, id string) (JobStatus, error) {
+	body, err := z.get(ctx, fmt.Sprintf("/job_statuses/%s.json", id))
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return unmarshalJobStatus(body)
+}
+
+// unmarshalJobStatus parses a response body which contains a job status
+func unmarshalJobStatus(body []byte) (JobStatus, error) {
+	var result struct {
+		JobStatus JobStatus `json:"job_status"`
+	}
+
+	err := json.Unmarshal(body, &result)
+	if err != nil {
+		return JobStatus{}, err
+	}
+	return result.JobStatus, nil
+}
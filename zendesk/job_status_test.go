@@ -0,0 +1,24 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetJobStatus(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "job_status.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	job, err := client.GetJobStatus(ctx, "8b726e606741012ffc2d782bcb7848fe")
+	if err != nil {
+		t.Fatalf("Failed to get job status: %s", err)
+	}
+
+	if job.Status != "completed" || job.Progress != 2 {
+		t.Fatalf("Job status was not parsed as expected: %v", job)
+	}
+	if len(job.Results) != 2 || job.Results[1].ID != 6 {
+		t.Fatalf("Job results were not parsed as expected: %v", job.Results)
+	}
+}
@@ -3,33 +3,78 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
 // Locale is zendesk locale JSON payload format
 // https://developer.zendesk.com/rest_api/docs/support/locales
 type Locale struct {
-	ID        int64     `json:"id"`
-	URL       string    `json:"url"`
-	Locale    string    `json:"locale"`
-	Name      string    `json:"name"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID               int64     `json:"id"`
+	URL              string    `json:"url"`
+	Locale           string    `json:"locale"`
+	Name             string    `json:"name"`
+	NativeName       string    `json:"native_name"`
+	PresentationName string    `json:"presentation_name"`
+	RTL              bool      `json:"rtl"`
+	Default          bool      `json:"default"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
 }
 
 // LocaleAPI an interface containing all of the local related zendesk methods
 type LocaleAPI interface {
 	GetLocales(ctx context.Context) ([]Locale, error)
+	GetLocale(ctx context.Context, id int64) (Locale, error)
+	GetCurrentLocale(ctx context.Context) (Locale, error)
+	GetAgentLocales(ctx context.Context) ([]Locale, error)
+	GetPublicLocales(ctx context.Context) ([]Locale, error)
+	DetectBestLocale(ctx context.Context, acceptLanguage string) (Locale, error)
 }
 
 // GetLocales lists the translation locales available for the account.
 // https://developer.zendesk.com/rest_api/docs/support/locales#list-locales
 func (z *Client) GetLocales(ctx context.Context) ([]Locale, error) {
+	return z.getLocales(ctx, "/locales.json")
+}
+
+// GetAgentLocales lists the translation locales that have been localized for agents.
+// https://developer.zendesk.com/rest_api/docs/support/locales#list-locales-for-agent
+func (z *Client) GetAgentLocales(ctx context.Context) ([]Locale, error) {
+	return z.getLocales(ctx, "/locales/agent.json")
+}
+
+// GetPublicLocales lists the translation locales that are available to all accounts.
+// https://developer.zendesk.com/rest_api/docs/support/locales#list-available-public-locales
+func (z *Client) GetPublicLocales(ctx context.Context) ([]Locale, error) {
+	return z.getLocales(ctx, "/locales/public.json")
+}
+
+// GetLocale gets a specified locale.
+// https://developer.zendesk.com/rest_api/docs/support/locales#show-locale
+func (z *Client) GetLocale(ctx context.Context, id int64) (Locale, error) {
+	return z.getLocale(ctx, fmt.Sprintf("/locales/%d.json", id))
+}
+
+// GetCurrentLocale gets the locale of the current user.
+// https://developer.zendesk.com/rest_api/docs/support/locales#show-current-locale
+func (z *Client) GetCurrentLocale(ctx context.Context) (Locale, error) {
+	return z.getLocale(ctx, "/locales/current.json")
+}
+
+// DetectBestLocale gets the best available locale for the given Accept-Language header value.
+// https://developer.zendesk.com/rest_api/docs/support/locales#detect-best-language-for-user
+func (z *Client) DetectBestLocale(ctx context.Context, acceptLanguage string) (Locale, error) {
+	return z.getLocale(ctx, "/locales/detect_best_locale.json", WithHeader("Accept-Language", acceptLanguage))
+}
+
+// getLocales gets a list of locales from the specified listing endpoint
+func (z *Client) getLocales(ctx context.Context, path string) ([]Locale, error) {
 	var data struct {
 		Locales []Locale `json:"locales"`
 	}
 
-	body, err := z.get(ctx, "/locales.json")
+	body, err := z.get(ctx, path)
 	if err != nil {
 		return nil, err
 	}
@@ -40,3 +85,21 @@ func (z *Client) GetLocales(ctx context.Context) ([]Locale, error) {
 	}
 	return data.Locales, nil
 }
+
+// getLocale gets a single locale from the specified endpoint
+func (z *Client) getLocale(ctx context.Context, path string, opts ...RequestOption) (Locale, error) {
+	var result struct {
+		Locale Locale `json:"locale"`
+	}
+
+	body, err := z.get(ctx, path, opts...)
+	if err != nil {
+		return Locale{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Locale{}, err
+	}
+	return result.Locale, nil
+}
@@ -2,6 +2,8 @@ package zendesk
 
 import (
 	"net/http"
+	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -18,4 +20,68 @@ func TestGetLocales(t *testing.T) {
 	if len(locales) != 3 {
 		t.Fatalf("expected length of groups is 3, but got %d", len(locales))
 	}
+
+	if !locales[0].Default || locales[1].NativeName != "日本語" {
+		t.Fatalf("Locales were not parsed as expected: %v", locales)
+	}
+}
+
+func TestGetAgentLocales(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/locales/agent.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "locales.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	locales, err := client.GetAgentLocales(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get agent locales: %s", err)
+	}
+
+	if len(locales) != 3 {
+		t.Fatalf("expected length of locales is 3, but got %d", len(locales))
+	}
+}
+
+func TestGetCurrentLocale(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/locales/current.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "locale.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	locale, err := client.GetCurrentLocale(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get current locale: %s", err)
+	}
+
+	if locale.ID != 67 || locale.Locale != "ja" {
+		t.Fatalf("Locale was not parsed as expected: %v", locale)
+	}
+}
+
+func TestDetectBestLocale(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := r.Header.Get("Accept-Language"); v != "ja,en;q=0.8" {
+			t.Fatalf(`Accept-Language header expect "ja,en;q=0.8", but got "%s"`, v)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "locale.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	locale, err := client.DetectBestLocale(ctx, "ja,en;q=0.8")
+	if err != nil {
+		t.Fatalf("Failed to detect best locale: %s", err)
+	}
+
+	if locale.Locale != "ja" {
+		t.Fatalf("Detected locale is not expected: %v", locale)
+	}
 }
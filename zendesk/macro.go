@@ -0,0 +1,291 @@
+package zendesk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"time"
+)
+
+// MacroAction is zendesk macro action
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/macros#actions
+type MacroAction struct {
+	Field string      `json:"field"`
+	Value interface{} `json:"value"`
+}
+
+// Macro is zendesk macro JSON payload format
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/macros#json-format
+type Macro struct {
+	ID          int64         `json:"id,omitempty"`
+	URL         string        `json:"url,omitempty"`
+	Title       string        `json:"title"`
+	Active      bool          `json:"active,omitempty"`
+	Position    int64         `json:"position,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Actions     []MacroAction `json:"actions"`
+	Restriction interface{}   `json:"restriction,omitempty"`
+	CreatedAt   *time.Time    `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time    `json:"updated_at,omitempty"`
+}
+
+// MacroAttachment is struct for macro attachment payload
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/macros#json-format-for-macro-attachments
+type MacroAttachment struct {
+	ID          int64     `json:"id"`
+	URL         string    `json:"url"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"content_type"`
+	ContentURL  string    `json:"content_url"`
+	Size        int64     `json:"size"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// MacroListOptions is options for GetActiveMacros
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/macros#list-active-macros
+type MacroListOptions struct {
+	PageOptions
+
+	// Access can take "personal", "shared" or "account"
+	Access string `url:"access,omitempty"`
+
+	// Category filters macros by the macro category
+	Category int64 `url:"category,omitempty"`
+
+	// GroupID filters macros by the group which they are restricted to
+	GroupID int64 `url:"group_id,omitempty"`
+
+	// OnlyViewable returns only macros which can be applied by the agent
+	OnlyViewable bool `url:"only_viewable,omitempty"`
+
+	// SortBy can take "alphabetical", "created_at", "updated_at",
+	// "usage_1h", "usage_24h", "usage_7d" or "position"
+	SortBy string `url:"sort_by,omitempty"`
+
+	// SortOrder can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// MacroAPI an interface containing all macro related methods
+type MacroAPI interface {
+	GetMacrosActive(ctx context.Context) ([]Macro, Page, error)
+	GetActiveMacros(ctx context.Context, opts *MacroListOptions) ([]Macro, Page, error)
+	GetMostUsedMacros(ctx context.Context) ([]Macro, Page, error)
+	SearchMacros(ctx context.Context, query string) ([]Macro, Page, error)
+	GetMacroCategories(ctx context.Context) ([]string, error)
+	GetMacroAttachments(ctx context.Context, macroID int64) ([]MacroAttachment, error)
+	CreateMacroAttachment(ctx context.Context, macroID int64, filename string, r io.Reader) (MacroAttachment, error)
+	ShowMacroReplica(ctx context.Context, macroID int64, ticketID int64) (Macro, error)
+}
+
+// GetMacrosActive fetches active macro list
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/macros#list-active-macros
+func (z *Client) GetMacrosActive(ctx context.Context) ([]Macro, Page, error) {
+	return z.GetActiveMacros(ctx, nil)
+}
+
+// GetActiveMacros fetches active macro list filtered and sorted by opts.
+// opts can be nil to list macros with default parameters
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/macros#list-active-macros
+func (z *Client) GetActiveMacros(ctx context.Context, opts *MacroListOptions) ([]Macro, Page, error) {
+	tmp := opts
+	if tmp == nil {
+		tmp = &MacroListOptions{}
+	}
+
+	u, err := addOptions("/macros/active.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return z.getMacros(ctx, u)
+}
+
+// GetMostUsedMacros fetches active macros in the order of usage in the last 7 days
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/macros#list-active-macros
+func (z *Client) GetMostUsedMacros(ctx context.Context) ([]Macro, Page, error) {
+	return z.GetActiveMacros(ctx, &MacroListOptions{
+		SortBy:    "usage_7d",
+		SortOrder: "desc",
+	})
+}
+
+// SearchMacros fetches macros whose title matches query
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/macros#search-macros
+func (z *Client) SearchMacros(ctx context.Context, query string) ([]Macro, Page, error) {
+	var opts struct {
+		Query string `url:"query"`
+	}
+	opts.Query = query
+
+	u, err := addOptions("/macros/search.json", opts)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return z.getMacros(ctx, u)
+}
+
+func (z *Client) getMacros(ctx context.Context, path string) ([]Macro, Page, error) {
+	var data struct {
+		Macros []Macro `json:"macros"`
+		Page
+	}
+
+	body, err := z.get(ctx, path)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Macros, data.Page, nil
+}
+
+// GetMacroCategories fetches the categories of macros
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/macros#list-macro-categories
+func (z *Client) GetMacroCategories(ctx context.Context) ([]string, error) {
+	var data struct {
+		Categories []string `json:"categories"`
+	}
+
+	body, err := z.get(ctx, "/macros/categories.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, err
+	}
+	return data.Categories, nil
+}
+
+// GetMacroAttachments fetches the attachments of the specified macro
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/macros#list-macro-attachments
+func (z *Client) GetMacroAttachments(ctx context.Context, macroID int64) ([]MacroAttachment, error) {
+	var data struct {
+		MacroAttachments []MacroAttachment `json:"macro_attachments"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/macros/%d/attachments.json", macroID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, err
+	}
+	return data.MacroAttachments, nil
+}
+
+// CreateMacroAttachment uploads the content of r as an attachment of the specified macro
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/macros#create-macro-attachment
+func (z *Client) CreateMacroAttachment(ctx context.Context, macroID int64, filename string, r io.Reader) (MacroAttachment, error) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+
+	if err := mw.WriteField("filename", filename); err != nil {
+		return MacroAttachment{}, err
+	}
+
+	part, err := mw.CreateFormFile("attachment", filename)
+	if err != nil {
+		return MacroAttachment{}, err
+	}
+
+	if _, err := io.Copy(part, r); err != nil {
+		return MacroAttachment{}, err
+	}
+
+	if err := mw.Close(); err != nil {
+		return MacroAttachment{}, err
+	}
+
+	path := fmt.Sprintf("/macros/%d/attachments.json", macroID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, z.resolve(path), &buf)
+	if err != nil {
+		return MacroAttachment{}, err
+	}
+
+	req = z.prepareRequest(ctx, req)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	resp, err := z.do(req)
+	if err != nil {
+		return MacroAttachment{}, err
+	}
+
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return MacroAttachment{}, err
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		return MacroAttachment{}, Error{
+			body: body,
+			resp: resp,
+		}
+	}
+
+	var result struct {
+		MacroAttachment MacroAttachment `json:"macro_attachment"`
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return MacroAttachment{}, err
+	}
+	return result.MacroAttachment, nil
+}
+
+// ShowMacroReplica returns an unpersisted macro which replicates the changes
+// the specified macro would make to the specified ticket
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/macros#show-macro-replica
+func (z *Client) ShowMacroReplica(ctx context.Context, macroID int64, ticketID int64) (Macro, error) {
+	var result struct {
+		Macro Macro `json:"macro"`
+	}
+
+	var opts struct {
+		MacroID  int64 `url:"macro_id"`
+		TicketID int64 `url:"ticket_id"`
+	}
+	opts.MacroID = macroID
+	opts.TicketID = ticketID
+
+	u, err := addOptions("/macros/new.json", opts)
+	if err != nil {
+		return Macro{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return Macro{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Macro{}, err
+	}
+	return result.Macro, nil
+}
@@ -0,0 +1,180 @@
+package zendesk
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGetMacrosActive(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "macros.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	macros, _, err := client.GetMacrosActive(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get active macros: %s", err)
+	}
+
+	if len(macros) != 1 || len(macros[0].Actions) != 2 {
+		t.Fatalf("Macros were not parsed as expected: %v", macros)
+	}
+}
+
+func TestGetActiveMacros(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/macros/active.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		if q := r.URL.Query(); q.Get("access") != "personal" || q.Get("group_id") != "360002440594" || q.Get("only_viewable") != "true" {
+			t.Fatalf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "macros.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	macros, _, err := client.GetActiveMacros(ctx, &MacroListOptions{
+		Access:       "personal",
+		GroupID:      360002440594,
+		OnlyViewable: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to get active macros: %s", err)
+	}
+
+	if len(macros) != 1 {
+		t.Fatalf("expected length of macros is 1, but got %d", len(macros))
+	}
+}
+
+func TestGetMostUsedMacros(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if q := r.URL.Query(); q.Get("sort_by") != "usage_7d" || q.Get("sort_order") != "desc" {
+			t.Fatalf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "macros.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	macros, _, err := client.GetMostUsedMacros(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get most used macros: %s", err)
+	}
+
+	if len(macros) != 1 {
+		t.Fatalf("expected length of macros is 1, but got %d", len(macros))
+	}
+}
+
+func TestSearchMacros(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/macros/search.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		if query := r.URL.Query().Get("query"); query != "close and" {
+			t.Fatalf(`query param expect "close and", but got "%s"`, query)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "macros.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	macros, _, err := client.SearchMacros(ctx, "close and")
+	if err != nil {
+		t.Fatalf("Failed to search macros: %s", err)
+	}
+
+	if len(macros) != 1 {
+		t.Fatalf("expected length of macros is 1, but got %d", len(macros))
+	}
+}
+
+func TestGetMacroCategories(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "macro_categories.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	categories, err := client.GetMacroCategories(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get macro categories: %s", err)
+	}
+
+	if len(categories) != 2 || categories[0] != "FAQ" || categories[1] != "Triage" {
+		t.Fatalf("Macro categories were not parsed as expected: %v", categories)
+	}
+}
+
+func TestGetMacroAttachments(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "macro_attachments.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attachments, err := client.GetMacroAttachments(ctx, 360111062754)
+	if err != nil {
+		t.Fatalf("Failed to get macro attachments: %s", err)
+	}
+
+	if len(attachments) != 1 || attachments[0].Filename != "foobar.jpg" {
+		t.Fatalf("Macro attachments were not parsed as expected: %v", attachments)
+	}
+}
+
+func TestCreateMacroAttachment(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/macros/360111062754/attachments.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+
+		file, header, err := r.FormFile("attachment")
+		if err != nil {
+			t.Fatalf("Request does not have attachment: %s", err)
+		}
+		content, _ := ioutil.ReadAll(file)
+		if header.Filename != "foobar.txt" || string(content) != "foobar" {
+			t.Fatalf("Attachment was not sent as expected: %s %s", header.Filename, content)
+		}
+		if v := r.FormValue("filename"); v != "foobar.txt" {
+			t.Fatalf(`filename expect "foobar.txt", but got "%s"`, v)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "macro_attachment.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	attachment, err := client.CreateMacroAttachment(ctx, 360111062754, "foobar.txt", strings.NewReader("foobar"))
+	if err != nil {
+		t.Fatalf("Failed to create macro attachment: %s", err)
+	}
+
+	if attachment.ID != 101 {
+		t.Fatalf("Returned macro attachment does not have the expected ID 101. Attachment id is %d", attachment.ID)
+	}
+}
+
+func TestShowMacroReplica(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("macro_id") != "360111062754" || q.Get("ticket_id") != "2" {
+			t.Fatalf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "macro.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	macro, err := client.ShowMacroReplica(ctx, 360111062754, 2)
+	if err != nil {
+		t.Fatalf("Failed to show macro replica: %s", err)
+	}
+
+	if macro.Title != "Replica of ticket 2" {
+		t.Fatalf("Macro was not parsed as expected: %v", macro)
+	}
+}
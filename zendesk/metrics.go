@@ -0,0 +1,43 @@
+package zendesk
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ObserveFunc is a hook which is called after each API request with the
+// endpoint label, HTTP status code and duration of the call.
+// statusCode is 0 when no response is received
+type ObserveFunc func(endpoint string, statusCode int, duration time.Duration)
+
+var numericSegmentRegexp = regexp.MustCompile(`^[0-9]+$`)
+
+// SetObserveFunc saves the metrics hook which is called after each API request
+func (z *Client) SetObserveFunc(observe ObserveFunc) {
+	z.observe = observe
+}
+
+// EndpointLabel converts a request path to a stable label for metrics.
+// Query string and ".json" suffix are removed and numeric IDs are replaced by
+// "{id}", e.g. "/tickets/123/comments.json?page=2" becomes "/tickets/{id}/comments"
+func EndpointLabel(path string) string {
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+	path = strings.TrimSuffix(path, ".json")
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if numericSegmentRegexp.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// endpointLabel returns endpoint label of the request path relative to base URL
+func (z *Client) endpointLabel(path string) string {
+	return EndpointLabel(strings.TrimPrefix(path, z.basePath()))
+}
@@ -0,0 +1,63 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestEndpointLabel(t *testing.T) {
+	cases := map[string]string{
+		"/tickets.json":                      "/tickets",
+		"/tickets/123.json":                  "/tickets/{id}",
+		"/tickets/123/comments.json?page=2":  "/tickets/{id}/comments",
+		"/views/360001/count.json":           "/views/{id}/count",
+		"/incremental/tickets.json?cursor=a": "/incremental/tickets",
+	}
+
+	for path, expected := range cases {
+		if label := EndpointLabel(path); label != expected {
+			t.Fatalf("EndpointLabel(%s) expect %s, but got %s", path, expected, label)
+		}
+	}
+}
+
+func TestSetObserveFunc(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/groups/2.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "group.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var endpoints []string
+	var statuses []int
+	client.SetObserveFunc(func(endpoint string, statusCode int, duration time.Duration) {
+		endpoints = append(endpoints, endpoint)
+		statuses = append(statuses, statusCode)
+	})
+
+	client.GetGroup(ctx, 1)
+	client.GetGroup(ctx, 2)
+	client.GetGroups(ctx)
+
+	expectedEndpoints := []string{"/groups/{id}", "/groups/{id}", "/groups"}
+	expectedStatuses := []int{http.StatusOK, http.StatusNotFound, http.StatusOK}
+	if len(endpoints) != len(expectedEndpoints) {
+		t.Fatalf("expected %d observations, but got %d", len(expectedEndpoints), len(endpoints))
+	}
+
+	for i := range expectedEndpoints {
+		if endpoints[i] != expectedEndpoints[i] {
+			t.Fatalf("endpoint %d expect %s, but got %s", i, expectedEndpoints[i], endpoints[i])
+		}
+		if statuses[i] != expectedStatuses[i] {
+			t.Fatalf("status %d expect %d, but got %d", i, expectedStatuses[i], statuses[i])
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// NPSRecipient is a user who was sent an NPS survey
+//
+// ref: https://developer.zendesk.com/rest_api/docs/nps-api/recipients#json-format
+type NPSRecipient struct {
+	ID          int64      `json:"id,omitempty"`
+	SurveyID    int64      `json:"survey_id,omitempty"`
+	UserID      int64      `json:"user_id,omitempty"`
+	Name        string     `json:"name,omitempty"`
+	Email       string     `json:"email,omitempty"`
+	Locale      string     `json:"locale,omitempty"`
+	Status      string     `json:"status,omitempty"`
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// NPSResponse is a response of a recipient to an NPS survey.
+// Rating is the score from 0 to 10
+//
+// ref: https://developer.zendesk.com/rest_api/docs/nps-api/responses#json-format
+type NPSResponse struct {
+	ID          int64      `json:"id,omitempty"`
+	SurveyID    int64      `json:"survey_id,omitempty"`
+	RecipientID int64      `json:"recipient_id,omitempty"`
+	UserID      int64      `json:"user_id,omitempty"`
+	Rating      int        `json:"rating"`
+	Comment     string     `json:"comment,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// NPSAPI an interface containing NPS export methods
+type NPSAPI interface {
+	GetIncrementalNPSResponses(ctx context.Context, startTime int64) ([]NPSResponse, int64, bool, error)
+	GetIncrementalNPSRecipients(ctx context.Context, startTime int64) ([]NPSRecipient, int64, bool, error)
+}
+
+// GetIncrementalNPSResponses get NPS responses changed since startTime, a UNIX timestamp.
+// It returns end_time of the page, which is startTime of the next page,
+// and whether it's the end of stream
+//
+// ref: https://developer.zendesk.com/rest_api/docs/nps-api/incremental_responses
+func (z *Client) GetIncrementalNPSResponses(ctx context.Context, startTime int64) ([]NPSResponse, int64, bool, error) {
+	var data struct {
+		Responses []NPSResponse `json:"responses"`
+		EndTime   int64         `json:"end_time"`
+		Count     int           `json:"count"`
+		EoS       *bool         `json:"end_of_stream"`
+	}
+
+	if err := z.getIncrementalNPS(ctx, "/nps/incremental/responses.json", startTime, &data); err != nil {
+		return nil, 0, true, err
+	}
+	return data.Responses, data.EndTime, endOfStream(data.EoS, data.Count), nil
+}
+
+// GetIncrementalNPSRecipients get NPS recipients changed since startTime, a UNIX timestamp.
+// It returns end_time of the page, which is startTime of the next page,
+// and whether it's the end of stream
+//
+// ref: https://developer.zendesk.com/rest_api/docs/nps-api/incremental_recipients
+func (z *Client) GetIncrementalNPSRecipients(ctx context.Context, startTime int64) ([]NPSRecipient, int64, bool, error) {
+	var data struct {
+		Recipients []NPSRecipient `json:"recipients"`
+		EndTime    int64          `json:"end_time"`
+		Count      int            `json:"count"`
+		EoS        *bool          `json:"end_of_stream"`
+	}
+
+	if err := z.getIncrementalNPS(ctx, "/nps/incremental/recipients.json", startTime, &data); err != nil {
+		return nil, 0, true, err
+	}
+	return data.Recipients, data.EndTime, endOfStream(data.EoS, data.Count), nil
+}
+
+func (z *Client) getIncrementalNPS(ctx context.Context, path string, startTime int64, v interface{}) error {
+	var opts struct {
+		StartTime int64 `url:"start_time"`
+	}
+	opts.StartTime = startTime
+
+	u, err := addOptions(path, opts)
+	if err != nil {
+		return err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
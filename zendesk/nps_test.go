@@ -0,0 +1,64 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetIncrementalNPSResponses(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/nps/incremental/responses.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		if st := r.URL.Query().Get("start_time"); st != "1585699200" {
+			t.Fatalf(`start_time param expect "1585699200", but got "%s"`, st)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "nps_incremental_responses.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	responses, endTime, eos, err := client.GetIncrementalNPSResponses(ctx, 1585699200)
+	if err != nil {
+		t.Fatalf("Failed to get NPS responses: %s", err)
+	}
+
+	if len(responses) != 2 {
+		t.Fatalf("expected length of NPS responses is 2, but got %d", len(responses))
+	}
+	if r := responses[0]; r.Rating != 9 || r.Comment != "Great support team" || r.RecipientID != 9023 {
+		t.Fatalf("NPS response was not parsed as expected: %v", r)
+	}
+	if r := responses[1]; r.Rating != 0 || r.Comment != "" {
+		t.Fatalf("NPS response was not parsed as expected: %v", r)
+	}
+	if endTime != 1585825361 {
+		t.Fatalf("end time expect 1585825361, but got %d", endTime)
+	}
+	if !eos {
+		t.Fatalf("expected a short page to be the end of stream")
+	}
+}
+
+func TestGetIncrementalNPSRecipients(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "nps_incremental_recipients.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	recipients, endTime, _, err := client.GetIncrementalNPSRecipients(ctx, 1585699200)
+	if err != nil {
+		t.Fatalf("Failed to get NPS recipients: %s", err)
+	}
+
+	if len(recipients) != 1 {
+		t.Fatalf("expected length of NPS recipients is 1, but got %d", len(recipients))
+	}
+	if r := recipients[0]; r.Email != "jane@example.com" || r.Status != "responded" || r.DeliveredAt == nil {
+		t.Fatalf("NPS recipient was not parsed as expected: %v", r)
+	}
+	if endTime != 1585822813 {
+		t.Fatalf("end time expect 1585822813, but got %d", endTime)
+	}
+}
@@ -0,0 +1,167 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// OAuthClient is struct for OAuth client payload
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/oauth_clients#json-format
+type OAuthClient struct {
+	ID          int64      `json:"id,omitempty"`
+	URL         string     `json:"url,omitempty"`
+	Name        string     `json:"name"`
+	Identifier  string     `json:"identifier"`
+	Company     string     `json:"company,omitempty"`
+	Description string     `json:"description,omitempty"`
+	LogoURL     string     `json:"logo_url,omitempty"`
+	UserID      int64      `json:"user_id,omitempty"`
+	Secret      string     `json:"secret,omitempty"`
+	RedirectURI []string   `json:"redirect_uri,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// OAuthToken is struct for OAuth access token payload
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/oauth_tokens#json-format
+type OAuthToken struct {
+	ID           int64      `json:"id,omitempty"`
+	URL          string     `json:"url,omitempty"`
+	ClientID     int64      `json:"client_id"`
+	UserID       int64      `json:"user_id,omitempty"`
+	Token        string     `json:"token,omitempty"`
+	FullToken    string     `json:"full_token,omitempty"`
+	RefreshToken string     `json:"refresh_token,omitempty"`
+	Scopes       []string   `json:"scopes"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+	UsedAt       *time.Time `json:"used_at,omitempty"`
+}
+
+// OAuthAPI an interface containing all OAuth client and token related methods
+type OAuthAPI interface {
+	GetOAuthClients(ctx context.Context) ([]OAuthClient, Page, error)
+	CreateOAuthClient(ctx context.Context, client OAuthClient) (OAuthClient, error)
+	DeleteOAuthClient(ctx context.Context, id int64) error
+	GetOAuthTokens(ctx context.Context) ([]OAuthToken, Page, error)
+	CreateOAuthToken(ctx context.Context, clientID int64, scopes []string) (OAuthToken, error)
+	RevokeOAuthToken(ctx context.Context, id int64) error
+}
+
+// GetOAuthClients fetches OAuth client list
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/oauth_clients#list-clients
+func (z *Client) GetOAuthClients(ctx context.Context) ([]OAuthClient, Page, error) {
+	var data struct {
+		Clients []OAuthClient `json:"clients"`
+		Page
+	}
+
+	body, err := z.get(ctx, "/oauth/clients.json")
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Clients, data.Page, nil
+}
+
+// CreateOAuthClient creates new OAuth client
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/oauth_clients#create-client
+func (z *Client) CreateOAuthClient(ctx context.Context, client OAuthClient) (OAuthClient, error) {
+	var data, result struct {
+		Client OAuthClient `json:"client"`
+	}
+	data.Client = client
+
+	body, err := z.post(ctx, "/oauth/clients.json", data)
+	if err != nil {
+		return OAuthClient{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return OAuthClient{}, err
+	}
+	return result.Client, nil
+}
+
+// DeleteOAuthClient deletes the specified OAuth client
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/oauth_clients#delete-client
+func (z *Client) DeleteOAuthClient(ctx context.Context, id int64) error {
+	err := z.delete(ctx, fmt.Sprintf("/oauth/clients/%d.json", id))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetOAuthTokens fetches OAuth access token list.
+// The token values are truncated in the list
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/oauth_tokens#list-tokens
+func (z *Client) GetOAuthTokens(ctx context.Context) ([]OAuthToken, Page, error) {
+	var data struct {
+		Tokens []OAuthToken `json:"tokens"`
+		Page
+	}
+
+	body, err := z.get(ctx, "/oauth/tokens.json")
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Tokens, data.Page, nil
+}
+
+// CreateOAuthToken creates new OAuth access token for the client with the scopes.
+// FullToken of the returned token is the only chance to get the full token value.
+// Zendesk never returns it again, so store it before discarding the result
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/oauth_tokens#create-token
+func (z *Client) CreateOAuthToken(ctx context.Context, clientID int64, scopes []string) (OAuthToken, error) {
+	var data, result struct {
+		Token OAuthToken `json:"token"`
+	}
+	data.Token = OAuthToken{
+		ClientID: clientID,
+		Scopes:   scopes,
+	}
+
+	body, err := z.post(ctx, "/oauth/tokens.json", data)
+	if err != nil {
+		return OAuthToken{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return OAuthToken{}, err
+	}
+	return result.Token, nil
+}
+
+// RevokeOAuthToken revokes the specified OAuth access token
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/oauth_tokens#revoke-token
+func (z *Client) RevokeOAuthToken(ctx context.Context, id int64) error {
+	err := z.delete(ctx, fmt.Sprintf("/oauth/tokens/%d.json", id))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetOAuthClients(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "oauth_clients.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	clients, _, err := client.GetOAuthClients(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get oauth clients: %s", err)
+	}
+
+	if len(clients) != 1 {
+		t.Fatalf("expected length of oauth clients is 1, but got %d", len(clients))
+	}
+
+	c := clients[0]
+	if c.ID != 223443 || c.Identifier != "test_client" || len(c.RedirectURI) != 1 {
+		t.Fatalf("OAuth client was not parsed as expected: %v", c)
+	}
+}
+
+func TestCreateOAuthClient(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "oauth_client.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	c, err := client.CreateOAuthClient(ctx, OAuthClient{
+		Name:       "My Integration",
+		Identifier: "my_integration",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create oauth client: %s", err)
+	}
+
+	if c.ID != 223444 {
+		t.Fatalf("Returned oauth client does not have the expected ID 223444. Client id is %d", c.ID)
+	}
+}
+
+func TestDeleteOAuthClient(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/oauth/clients/223443.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteOAuthClient(ctx, 223443); err != nil {
+		t.Fatalf("Failed to delete oauth client: %s", err)
+	}
+}
+
+func TestGetOAuthTokens(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "oauth_tokens.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tokens, _, err := client.GetOAuthTokens(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get oauth tokens: %s", err)
+	}
+
+	if len(tokens) != 1 || tokens[0].Scopes[0] != "read" {
+		t.Fatalf("OAuth tokens were not parsed as expected: %v", tokens)
+	}
+}
+
+func TestCreateOAuthToken(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Token struct {
+				ClientID int64    `json:"client_id"`
+				Scopes   []string `json:"scopes"`
+			} `json:"token"`
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("Failed to parse request body: %s", err)
+		}
+		if data.Token.ClientID != 223443 {
+			t.Fatalf("client_id was not sent as expected: %s", body)
+		}
+		if len(data.Token.Scopes) != 2 || data.Token.Scopes[0] != "tickets:read" || data.Token.Scopes[1] != "users:write" {
+			t.Fatalf("scopes were not sent as expected: %s", body)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "oauth_token.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	token, err := client.CreateOAuthToken(ctx, 223443, []string{"tickets:read", "users:write"})
+	if err != nil {
+		t.Fatalf("Failed to create oauth token: %s", err)
+	}
+
+	if token.FullToken == "" {
+		t.Fatal("Returned oauth token does not have the full token")
+	}
+}
+
+func TestRevokeOAuthToken(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/oauth/tokens/223443.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.RevokeOAuthToken(ctx, 223443); err != nil {
+		t.Fatalf("Failed to revoke oauth token: %s", err)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"time"
+
+	"github.com/tylerconlee/zendesk-go/zendesk/sideload"
 )
 
 // Organization is struct for organization payload
@@ -13,6 +15,9 @@ type Organization struct {
 	ID             int64     `json:"id,omitempty"`
 	URL            string    `json:"url,omitempty"`
 	Name           string    `json:"name"`
+	ExternalID     string    `json:"external_id,omitempty"`
+	Details        string    `json:"details,omitempty"`
+	Notes          string    `json:"notes,omitempty"`
 	DomainNames    []string  `json:"domain_names"`
 	GroupID        int64     `json:"group_id"`
 	SharedTickets  bool      `json:"shared_tickets"`
@@ -20,14 +25,90 @@ type Organization struct {
 	Tags           []string  `json:"tags"`
 	CreatedAt      time.Time `json:"created_at,omitempty"`
 	UpdatedAt      time.Time `json:"updated_at,omitempty"`
+
+	// TicketsCount and UsersCount are set only when they are requested
+	// with include=tickets_count,users_count
+	TicketsCount *int64 `json:"tickets_count,omitempty"`
+	UsersCount   *int64 `json:"users_count,omitempty"`
+}
+
+// OrganizationListOptions is options for GetOrganizations
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/organizations#list-organizations
+type OrganizationListOptions struct {
+	PageOptions
+
+	// Sideload includes additional data, e.g. "tickets_count,users_count".
+	// Keys of sideloaders passed to GetOrganizations are appended to it
+	Sideload string `url:"include,omitempty"`
+}
+
+// OrganizationIncrementalOptions is options for GetIncrementalOrganizations.
+// Set StartTime to the end time returned by the previous page to get the next one
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/incremental_export#incremental-organization-export
+type OrganizationIncrementalOptions struct {
+	StartTime int64 `url:"start_time"`
+	PerPage   int   `url:"per_page,omitempty"`
+
+	// Sideload includes additional data, e.g. "tickets_count"
+	Sideload string `url:"include,omitempty"`
+}
+
+// Validate checks PerPage is within the page limit of incremental export
+func (o OrganizationIncrementalOptions) Validate() error {
+	return validatePerPage(o.PerPage, incrementalExportLimit)
 }
 
 // OrganizationAPI an interface containing all methods associated with zendesk organizations
 type OrganizationAPI interface {
+	GetOrganizations(ctx context.Context, opts *OrganizationListOptions, sideLoad ...sideload.SideLoader) ([]Organization, Page, error)
 	CreateOrganization(ctx context.Context, org Organization) (Organization, error)
 	GetOrganization(ctx context.Context, orgID int64) (Organization, error)
-	UpdateOrganization(ctx context.Context, orgID int64, org Organization) (Organization, error)
+	UpdateOrganization(ctx context.Context, orgID int64, update OrganizationUpdate) (Organization, error)
 	DeleteOrganization(ctx context.Context, orgID int64) error
+	AutocompleteOrganizations(ctx context.Context, name string) ([]Organization, error)
+	GetIncrementalOrganizations(ctx context.Context, opts *OrganizationIncrementalOptions) ([]Organization, int64, bool, error)
+}
+
+// GetOrganizations fetch organization list.
+// sideload.OrgUsers loads the users of the organizations with the same request
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/organizations#list-organizations
+func (z *Client) GetOrganizations(ctx context.Context, opts *OrganizationListOptions, sideLoad ...sideload.SideLoader) ([]Organization, Page, error) {
+	var data struct {
+		Organizations []Organization `json:"organizations"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &OrganizationListOptions{}
+	}
+
+	o := *tmp
+	o.Sideload = includeKeys(o.Sideload, sideLoad)
+
+	u, err := addOptions("/organizations.json", o)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = unmarshalSideLoads(body, sideLoad)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Organizations, data.Page, nil
 }
 
 // CreateOrganization creates new organization
@@ -73,14 +154,17 @@ func (z *Client) GetOrganization(ctx context.Context, orgID int64) (Organization
 	return result.Organization, err
 }
 
-// UpdateOrganization updates a organization with the specified organization
+// UpdateOrganization updates a organization with the fields set in update
 // ref: https://developer.zendesk.com/rest_api/docs/support/organizations#update-organization
-func (z *Client) UpdateOrganization(ctx context.Context, orgID int64, org Organization) (Organization, error) {
-	var result, data struct {
+func (z *Client) UpdateOrganization(ctx context.Context, orgID int64, update OrganizationUpdate) (Organization, error) {
+	var result struct {
 		Organization Organization `json:"organization"`
 	}
+	var data struct {
+		Organization OrganizationUpdate `json:"organization"`
+	}
 
-	data.Organization = org
+	data.Organization = update
 
 	body, err := z.put(ctx, fmt.Sprintf("/organizations/%d.json", orgID), data)
 
@@ -107,3 +191,67 @@ func (z *Client) DeleteOrganization(ctx context.Context, orgID int64) error {
 
 	return nil
 }
+
+// AutocompleteOrganizations fetches organizations whose name starts with name.
+// The name must be at least 2 characters long
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/organizations#autocomplete-organizations
+func (z *Client) AutocompleteOrganizations(ctx context.Context, name string) ([]Organization, error) {
+	var data struct {
+		Organizations []Organization `json:"organizations"`
+	}
+
+	opts := struct {
+		Name string `url:"name"`
+	}{Name: name}
+
+	u, err := addOptions("/organizations/autocomplete.json", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, err
+	}
+	return data.Organizations, nil
+}
+
+// GetIncrementalOrganizations exports organizations changed since StartTime of opts.
+// It returns the end time to start the next page from, and whether the export reached the end.
+// Include "tickets_count" in Sideload to get TicketsCount of the organizations
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/incremental_export#incremental-organization-export
+func (z *Client) GetIncrementalOrganizations(ctx context.Context, opts *OrganizationIncrementalOptions) ([]Organization, int64, bool, error) {
+	var data struct {
+		Organizations []Organization `json:"organizations"`
+		EndTime       int64          `json:"end_time"`
+		EoS           bool           `json:"end_of_stream"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &OrganizationIncrementalOptions{}
+	}
+
+	u, err := addOptions("/incremental/organizations.json", tmp)
+	if err != nil {
+		return nil, 0, true, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, 0, true, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, 0, true, err
+	}
+	return data.Organizations, data.EndTime, data.EoS, nil
+}
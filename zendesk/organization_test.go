@@ -3,7 +3,10 @@ package zendesk
 import (
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
+
+	"github.com/tylerconlee/zendesk-go/zendesk/sideload"
 )
 
 func TestCreateOrganization(t *testing.T) {
@@ -17,6 +20,78 @@ func TestCreateOrganization(t *testing.T) {
 	}
 }
 
+func TestGetOrganizationsWithUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if include := r.URL.Query().Get("include"); include != "tickets_count,users_count,users" {
+			t.Fatalf(`include param expect "tickets_count,users_count,users", but got "%s"`, include)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "organizations.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var users []User
+	orgs, _, err := client.GetOrganizations(ctx, &OrganizationListOptions{
+		Sideload: "tickets_count,users_count",
+	}, sideload.OrgUsers(&users))
+	if err != nil {
+		t.Fatalf("Failed to get organizations: %s", err)
+	}
+
+	if len(orgs) != 2 {
+		t.Fatalf("expected length of organizations is 2, but got %d", len(orgs))
+	}
+	if orgs[0].TicketsCount == nil || *orgs[0].TicketsCount != 12 {
+		t.Fatalf("tickets_count of organization was not parsed as expected: %v", orgs[0].TicketsCount)
+	}
+	if orgs[1].UsersCount == nil || *orgs[1].UsersCount != 1 {
+		t.Fatalf("users_count of organization was not parsed as expected: %v", orgs[1].UsersCount)
+	}
+
+	if len(users) != 3 {
+		t.Fatalf("expected length of sideloaded users is 3, but got %d", len(users))
+	}
+	if users[2].OrganizationID != 361898904440 {
+		t.Fatalf("sideloaded user has unexpected organization id %d", users[2].OrganizationID)
+	}
+}
+
+func TestGetIncrementalOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incremental/organizations.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("start_time") != "1568700000" || q.Get("include") != "tickets_count" {
+			t.Fatalf("Request does not have expected params: %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "incremental_organizations.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	orgs, endTime, eos, err := client.GetIncrementalOrganizations(ctx, &OrganizationIncrementalOptions{
+		StartTime: 1568700000,
+		Sideload:  "tickets_count",
+	})
+	if err != nil {
+		t.Fatalf("Failed to get incremental organizations: %s", err)
+	}
+
+	if len(orgs) != 2 {
+		t.Fatalf("expected length of organizations is 2, but got %d", len(orgs))
+	}
+	if orgs[0].TicketsCount == nil || *orgs[0].TicketsCount != 12 {
+		t.Fatalf("tickets_count of organization was not parsed as expected: %v", orgs[0].TicketsCount)
+	}
+	if orgs[1].TicketsCount != nil {
+		t.Fatalf("expected tickets_count of organization to be nil, but got %d", *orgs[1].TicketsCount)
+	}
+	if endTime != 1568793771 || !eos {
+		t.Fatalf("expected end time 1568793771 and end of stream, but got %d and %v", endTime, eos)
+	}
+}
+
 func TestGetOrganization(t *testing.T) {
 	mockAPI := newMockAPI(http.MethodGet, "organization.json")
 	client := newTestClient(mockAPI)
@@ -38,7 +113,7 @@ func TestUpdateOrganization(t *testing.T) {
 	client := newTestClient(mockAPI)
 	defer mockAPI.Close()
 
-	updatedOrg, err := client.UpdateOrganization(ctx, int64(1234), Organization{})
+	updatedOrg, err := client.UpdateOrganization(ctx, int64(1234), OrganizationUpdate{})
 	if err != nil {
 		t.Fatalf("Failed to send request to create organization: %s", err)
 	}
@@ -61,3 +136,29 @@ func TestDeleteOrganization(t *testing.T) {
 		t.Fatalf("Failed to delete organization: %s", err)
 	}
 }
+
+func TestAutocompleteOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organizations/autocomplete.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		if name := r.URL.Query().Get("name"); name != "Reb" {
+			t.Fatalf("name expect Reb, but got %s", name)
+		}
+		w.Write([]byte(`{"organizations":[{"id":35436,"name":"Rebel Alliance"}],"next_page":null,"previous_page":null,"count":1}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	orgs, err := client.AutocompleteOrganizations(ctx, "Reb")
+	if err != nil {
+		t.Fatalf("Failed to autocomplete organizations: %s", err)
+	}
+
+	if len(orgs) != 1 {
+		t.Fatalf("expected length of organizations is 1, but got %d", len(orgs))
+	}
+	if orgs[0].Name != "Rebel Alliance" {
+		t.Fatalf("organization name expect Rebel Alliance, but got %s", orgs[0].Name)
+	}
+}
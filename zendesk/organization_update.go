@@ -0,0 +1,18 @@
+package zendesk
+
+// OrganizationUpdate is a payload of UpdateOrganization.
+// Unlike Organization, a nil field is left as is and a non-nil field is written
+// even if it points to a zero value, e.g. an empty string to clear notes
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/organizations#update-organization
+type OrganizationUpdate struct {
+	Name           *string   `json:"name,omitempty"`
+	ExternalID     *string   `json:"external_id,omitempty"`
+	Details        *string   `json:"details,omitempty"`
+	Notes          *string   `json:"notes,omitempty"`
+	DomainNames    *[]string `json:"domain_names,omitempty"`
+	GroupID        *int64    `json:"group_id,omitempty"`
+	SharedTickets  *bool     `json:"shared_tickets,omitempty"`
+	SharedComments *bool     `json:"shared_comments,omitempty"`
+	Tags           *[]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,42 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestOrganizationUpdateSendsOnlySetFields(t *testing.T) {
+	b, err := json.Marshal(OrganizationUpdate{
+		Notes:         String(""),
+		SharedTickets: Bool(false),
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal organization update: %s", err)
+	}
+
+	expected := `{"notes":"","shared_tickets":false}`
+	if string(b) != expected {
+		t.Fatalf("json expect %s, but got %s", expected, string(b))
+	}
+}
+
+func TestUpdateOrganizationClearNotes(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		expected := `{"organization":{"notes":""}}`
+		if string(body) != expected {
+			t.Fatalf("request body expect %s, but got %s", expected, string(body))
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "organization.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.UpdateOrganization(ctx, 361898904439, OrganizationUpdate{Notes: String("")}); err != nil {
+		t.Fatalf("Failed to update organization: %s", err)
+	}
+}
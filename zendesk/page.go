@@ -1,5 +1,18 @@
 package zendesk
 
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// MaxPerPage is the max number of records Zendesk returns in a page
+const MaxPerPage = 100
+
+// incrementalExportLimit is the max number of items in a page of incremental export.
+// In time based export, a shorter page is the last one
+const incrementalExportLimit = 1000
+
 // Page is base struct for resource pagination
 type Page struct {
 	PreviousPage *string `json:"previous_page"`
@@ -12,16 +25,112 @@ type Page struct {
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/introduction#pagination
 type PageOptions struct {
+	// PerPage is the number of records in a page. Zero uses the API default
 	PerPage int `url:"per_page,omitempty"`
-	Page    int `url:"page,omitempty"`
+	// Page is the page number starting from 1. Zero means the first page
+	Page int `url:"page,omitempty"`
+}
+
+// CursorOptions is options for list methods with cursor based pagination.
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/introduction#using-cursor-based-pagination
+type CursorOptions struct {
+	// PageSize is the number of records in a page. Zero uses the API default
+	PageSize int `url:"page[size],omitempty"`
+	// PageAfter is AfterCursor of the previous page. Empty means the first page
+	PageAfter string `url:"page[after],omitempty"`
+}
+
+// CursorMeta is the pagination meta of a page with cursor based pagination
+type CursorMeta struct {
+	HasMore      bool   `json:"has_more"`
+	AfterCursor  string `json:"after_cursor"`
+	BeforeCursor string `json:"before_cursor"`
+}
+
+// Validate checks CursorOptions is within the range Zendesk accepts
+func (o CursorOptions) Validate() error {
+	if o.PageSize < 0 || o.PageSize > MaxPerPage {
+		return fmt.Errorf("page[size] must be between 0 and %d, but got %d", MaxPerPage, o.PageSize)
+	}
+	return nil
+}
+
+// Validate checks PageOptions is within the range Zendesk accepts.
+// addOptions calls it for every options embedding PageOptions
+func (o PageOptions) Validate() error {
+	return o.validate(MaxPerPage)
+}
+
+// validate checks PageOptions with the max per_page of the endpoint.
+// Incremental exports accept up to incrementalExportLimit
+func (o PageOptions) validate(maxPerPage int) error {
+	if err := validatePerPage(o.PerPage, maxPerPage); err != nil {
+		return err
+	}
+	if o.Page < 0 {
+		return fmt.Errorf("page must not be negative, but got %d", o.Page)
+	}
+	return nil
+}
+
+// endOfStream tells whether a page of time based incremental export is the last one.
+// end_of_stream of the payload is used if it has, and a page shorter than
+// incrementalExportLimit is the last one otherwise
+func endOfStream(eos *bool, count int) bool {
+	if eos != nil {
+		return *eos
+	}
+	return count < incrementalExportLimit
+}
+
+func validatePerPage(perPage, maxPerPage int) error {
+	if perPage < 0 || perPage > maxPerPage {
+		return fmt.Errorf("per_page must be between 0 and %d, but got %d", maxPerPage, perPage)
+	}
+	return nil
 }
 
 // HasPrev checks if the Page has previous page
 func (p Page) HasPrev() bool {
-	return (p.PreviousPage != nil)
+	return (p.PreviousPage != nil && *p.PreviousPage != "")
+}
+
+// HasPrevious is an alias of HasPrev
+func (p Page) HasPrevious() bool {
+	return p.HasPrev()
 }
 
 // HasNext checks if the Page has next page
 func (p Page) HasNext() bool {
-	return (p.NextPage != nil)
+	return (p.NextPage != nil && *p.NextPage != "")
+}
+
+// NextPageNumber extracts the page number from the next_page URL.
+// It returns false if there is no next page or the URL has no page number
+func (p Page) NextPageNumber() (int, bool) {
+	return pageNumber(p.NextPage)
+}
+
+// PreviousPageNumber extracts the page number from the previous_page URL.
+// It returns false if there is no previous page or the URL has no page number
+func (p Page) PreviousPageNumber() (int, bool) {
+	return pageNumber(p.PreviousPage)
+}
+
+func pageNumber(pageURL *string) (int, bool) {
+	if pageURL == nil || *pageURL == "" {
+		return 0, false
+	}
+
+	u, err := url.Parse(*pageURL)
+	if err != nil {
+		return 0, false
+	}
+
+	n, err := strconv.Atoi(u.Query().Get("page"))
+	if err != nil {
+		return 0, false
+	}
+	return n, true
 }
@@ -23,3 +23,107 @@ func TestHasPrev(t *testing.T) {
 		t.Fatalf("expect false, but got true")
 	}
 }
+
+func TestPageOptionsValidate(t *testing.T) {
+	valid := []PageOptions{
+		{},
+		{PerPage: 1, Page: 1},
+		{PerPage: MaxPerPage, Page: 10},
+	}
+	for _, o := range valid {
+		if err := o.Validate(); err != nil {
+			t.Fatalf("expect %v is valid, but got %s", o, err)
+		}
+	}
+
+	invalid := []PageOptions{
+		{PerPage: MaxPerPage + 1},
+		{PerPage: -1},
+		{Page: -1},
+	}
+	for _, o := range invalid {
+		if err := o.Validate(); err == nil {
+			t.Fatalf("expect %v is invalid, but got no error", o)
+		}
+	}
+}
+
+func TestCursorOptionsValidate(t *testing.T) {
+	valid := []CursorOptions{
+		{},
+		{PageSize: MaxPerPage, PageAfter: "xxx"},
+	}
+	for _, o := range valid {
+		if err := o.Validate(); err != nil {
+			t.Fatalf("expect %v is valid, but got %s", o, err)
+		}
+	}
+
+	invalid := []CursorOptions{
+		{PageSize: MaxPerPage + 1},
+		{PageSize: -1},
+	}
+	for _, o := range invalid {
+		if err := o.Validate(); err == nil {
+			t.Fatalf("expect %v is invalid, but got no error", o)
+		}
+	}
+}
+
+func TestAddOptionsValidatesPageOptions(t *testing.T) {
+	_, err := addOptions("/tickets.json", &TicketListOptions{
+		PageOptions: PageOptions{PerPage: 101},
+	})
+	if err == nil {
+		t.Fatal("addOptions should fail when per_page exceeds the limit")
+	}
+
+	u, err := addOptions("/tickets.json", &TicketListOptions{
+		PageOptions: PageOptions{PerPage: 100, Page: 2},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add options: %s", err)
+	}
+	if u != "/tickets.json?page=2&per_page=100" {
+		t.Fatalf("Unexpected path %s", u)
+	}
+}
+
+func TestPageNumber(t *testing.T) {
+	next := "https://example.zendesk.com/api/v2/tickets.json?page=3&per_page=100"
+	prev := "https://example.zendesk.com/api/v2/tickets.json?page=1&per_page=100"
+	page := Page{NextPage: &next, PreviousPage: &prev}
+
+	if n, ok := page.NextPageNumber(); !ok || n != 3 {
+		t.Fatalf("expect next page number 3, but got %d (%v)", n, ok)
+	}
+	if n, ok := page.PreviousPageNumber(); !ok || n != 1 {
+		t.Fatalf("expect previous page number 1, but got %d (%v)", n, ok)
+	}
+	if !page.HasPrevious() {
+		t.Fatalf("expect true, but got false")
+	}
+}
+
+func TestPageNumberWithEmptyURL(t *testing.T) {
+	empty := ""
+	noPage := "https://example.zendesk.com/api/v2/tickets.json?per_page=100"
+
+	cases := []Page{
+		{},
+		{NextPage: &empty},
+		{NextPage: &noPage},
+	}
+	for _, p := range cases {
+		if _, ok := p.NextPageNumber(); ok {
+			t.Fatalf("expect no next page number for %v", p)
+		}
+	}
+
+	if (Page{NextPage: &empty}).HasNext() {
+		t.Fatalf("expect false for empty next_page, but got true")
+	}
+	if (Page{PreviousPage: &empty}).HasPrevious() {
+		t.Fatalf("expect false for empty previous_page, but got true")
+	}
+}
@@ -0,0 +1,75 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// ProblemAPI an interface containing problem ticket related methods
+type ProblemAPI interface {
+	GetProblems(ctx context.Context, opts *PageOptions) ([]Ticket, Page, error)
+	AutocompleteProblems(ctx context.Context, text string) ([]Ticket, error)
+}
+
+// GetProblems get problem tickets
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#list-ticket-problems
+func (z *Client) GetProblems(ctx context.Context, opts *PageOptions) ([]Ticket, Page, error) {
+	var data struct {
+		Tickets []Ticket `json:"tickets"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	u, err := addOptions("/problems.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Tickets, data.Page, nil
+}
+
+// AutocompleteProblems get problem tickets whose subject contains text.
+// It's useful to pick a problem to link an incident to
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#autocomplete-problems
+func (z *Client) AutocompleteProblems(ctx context.Context, text string) ([]Ticket, error) {
+	var data struct {
+		Tickets []Ticket `json:"tickets"`
+	}
+
+	var opts struct {
+		Text string `url:"text"`
+	}
+	opts.Text = text
+
+	u, err := addOptions("/problems/autocomplete.json", opts)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.send(ctx, http.MethodPost, u, nil, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, err
+	}
+	return data.Tickets, nil
+}
@@ -0,0 +1,49 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetProblems(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "problems.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	problems, _, err := client.GetProblems(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get problems: %s", err)
+	}
+
+	if len(problems) != 2 {
+		t.Fatalf("expected length of problems is 2, but got %d", len(problems))
+	}
+	if p := problems[0]; p.ID != 33 || p.Type != "problem" || !p.HasIncidents {
+		t.Fatalf("Problem was not parsed as expected: %v", p)
+	}
+}
+
+func TestAutocompleteProblems(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/problems/autocomplete.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if text := r.URL.Query().Get("text"); text != "printer" {
+			t.Fatalf(`text param expect "printer", but got "%s"`, text)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "problems.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	problems, err := client.AutocompleteProblems(ctx, "printer")
+	if err != nil {
+		t.Fatalf("Failed to autocomplete problems: %s", err)
+	}
+
+	if len(problems) != 2 {
+		t.Fatalf("expected length of problems is 2, but got %d", len(problems))
+	}
+}
@@ -0,0 +1,197 @@
+package zendesk
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// RecorderMode is the mode of Recorder
+type RecorderMode int
+
+const (
+	// RecorderModeRecord sends requests and saves their responses as cassettes
+	RecorderModeRecord RecorderMode = iota
+	// RecorderModeReplay serves saved cassettes without sending requests
+	RecorderModeReplay
+)
+
+// Recorder is an http.RoundTripper which records API responses to JSON files
+// under a directory and replays them, so that tests can run against captured
+// Zendesk traffic. Use it as the transport of the *http.Client passed to NewClient.
+//
+// Each interaction is saved in its own cassette file keyed by the method, path,
+// query and body of the request. The host is not part of the key, so cassettes
+// recorded against a real account can be replayed against any base URL.
+// Request headers, including Authorization, are never saved, and only the
+// response headers in recordedHeaders are, so cookies don't end up in cassettes
+type Recorder struct {
+	dir       string
+	mode      RecorderMode
+	transport http.RoundTripper
+}
+
+// recordedHeaders are the response headers saved in cassettes
+var recordedHeaders = []string{"Content-Type", "Location", "Retry-After"}
+
+// cassette is the file format of a recorded interaction
+type cassette struct {
+	Request struct {
+		Method string `json:"method"`
+		URL    string `json:"url"`
+		Body   string `json:"body,omitempty"`
+	} `json:"request"`
+	Response struct {
+		StatusCode int         `json:"status_code"`
+		Header     http.Header `json:"header"`
+		Body       string      `json:"body"`
+	} `json:"response"`
+}
+
+// NewRecorder creates a Recorder which saves or reads cassettes in dir.
+// transport sends requests in record mode, and http.DefaultTransport is used if it's nil
+func NewRecorder(dir string, mode RecorderMode, transport http.RoundTripper) *Recorder {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &Recorder{dir: dir, mode: mode, transport: transport}
+}
+
+// RoundTrip records or replays the request depending on the mode.
+// req is not modified, and a copy of it carries the read body in record mode
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	file := filepath.Join(r.dir, cassetteName(req, reqBody))
+	if r.mode == RecorderModeReplay {
+		return r.replay(req, file)
+	}
+
+	out := req
+	if req.Body != nil {
+		out = req.Clone(req.Context())
+		out.Body = ioutil.NopCloser(bytes.NewReader(reqBody))
+	}
+	return r.record(out, reqBody, file)
+}
+
+// readRequestBody reads the body of req from a copy by GetBody when it's
+// available. The body of req itself is only consumed and closed
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	defer req.Body.Close()
+
+	body := req.Body
+	if req.GetBody != nil {
+		var err error
+		body, err = req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+	}
+	return ioutil.ReadAll(body)
+}
+
+func (r *Recorder) record(req *http.Request, reqBody []byte, file string) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cassettes keep plain bodies so that they can be read and edited
+	if err := decodeContentEncoding(resp); err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	var c cassette
+	c.Request.Method = req.Method
+	c.Request.URL = req.URL.RequestURI()
+	c.Request.Body = string(reqBody)
+	c.Response.StatusCode = resp.StatusCode
+	c.Response.Header = http.Header{}
+	for _, key := range recordedHeaders {
+		if v, ok := resp.Header[key]; ok {
+			c.Response.Header[key] = v
+		}
+	}
+	c.Response.Body = string(body)
+
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(r.dir, 0755); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(file, b, 0644); err != nil {
+		return nil, err
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request, file string) (*http.Response, error) {
+	b, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("no cassette recorded for %s %s", req.Method, req.URL.RequestURI())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var c cassette
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, err
+	}
+
+	header := c.Response.Header
+	if header == nil {
+		header = http.Header{}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", c.Response.StatusCode, http.StatusText(c.Response.StatusCode)),
+		StatusCode:    c.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(c.Response.Body)),
+		ContentLength: int64(len(c.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+var cassetteNameRegexp = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// cassetteName returns the file name of the cassette for the request.
+// It's readable from the path, and the hash tells apart requests
+// which differ only in query or body
+func cassetteName(req *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(req.Method + " " + req.URL.RequestURI() + "\n"))
+	h.Write(body)
+	sum := hex.EncodeToString(h.Sum(nil))[:12]
+
+	path := strings.Trim(cassetteNameRegexp.ReplaceAllString(req.URL.Path, "_"), "_")
+	return fmt.Sprintf("%s_%s_%s.json", strings.ToLower(req.Method), path, sum)
+}
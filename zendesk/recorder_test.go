@@ -0,0 +1,106 @@
+package zendesk
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newRecorderTestClient(t *testing.T, endpoint string, rec *Recorder) *Client {
+	client, err := NewClient(&http.Client{Transport: rec})
+	if err != nil {
+		t.Fatalf("Failed to create client: %s", err)
+	}
+	if err := client.SetEndpointURL(endpoint); err != nil {
+		t.Fatalf("Failed to set endpoint: %s", err)
+	}
+	return client
+}
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassettes")
+	if err != nil {
+		t.Fatalf("Failed to create cassette directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	mockAPI := newMockAPI(http.MethodGet, "ticket.json")
+	client := newRecorderTestClient(t, mockAPI.URL, NewRecorder(dir, RecorderModeRecord, nil))
+	client.SetCredential(NewAPITokenCredential("john.doe@example.com", "apitoken"))
+
+	recorded, err := client.GetTicket(ctx, 2)
+	mockAPI.Close()
+	if err != nil {
+		t.Fatalf("Failed to get ticket: %s", err)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 1 {
+		t.Fatalf("expected 1 cassette to be recorded, but got %d", len(files))
+	}
+	b, _ := ioutil.ReadFile(files[0])
+	if strings.Contains(string(b), "Authorization") {
+		t.Fatalf("cassette must not contain credentials: %s", string(b))
+	}
+
+	// The mock server is closed, so the ticket can only come from the cassette
+	client = newRecorderTestClient(t, mockAPI.URL, NewRecorder(dir, RecorderModeReplay, nil))
+	replayed, err := client.GetTicket(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to replay ticket: %s", err)
+	}
+	if replayed.ID != recorded.ID || replayed.Subject != recorded.Subject {
+		t.Fatalf("replayed ticket %v is different from recorded one %v", replayed, recorded)
+	}
+
+	if _, err := client.GetTicket(ctx, 3); err == nil {
+		t.Fatalf("expected an error for a request without cassette")
+	}
+}
+
+func TestRecorderRecordsOnlyAllowedHeaders(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassettes")
+	if err != nil {
+		t.Fatalf("Failed to create cassette directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		if string(b) != `{"ticket":{}}` {
+			t.Fatalf("Unexpected request body %s", string(b))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "_zendesk_session=secret")
+		w.Write([]byte(`{"ticket":{"id":1}}`))
+	}))
+	defer mockAPI.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, mockAPI.URL+"/tickets.json", strings.NewReader(`{"ticket":{}}`))
+	body := req.Body
+	resp, err := NewRecorder(dir, RecorderModeRecord, nil).RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Failed to record: %s", err)
+	}
+	resp.Body.Close()
+
+	if req.Body != body {
+		t.Fatal("RoundTrip must not replace the body of the request")
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 1 {
+		t.Fatalf("expected 1 cassette to be recorded, but got %d", len(files))
+	}
+	b, _ := ioutil.ReadFile(files[0])
+	if strings.Contains(string(b), "Set-Cookie") || strings.Contains(string(b), "secret") {
+		t.Fatalf("cassette must not contain cookies: %s", string(b))
+	}
+	if !strings.Contains(string(b), "Content-Type") {
+		t.Fatalf("cassette should keep Content-Type: %s", string(b))
+	}
+}
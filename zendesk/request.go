@@ -0,0 +1,188 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Requester is the user who is created on the fly when an anonymous
+// or a new user submits a request
+type Requester struct {
+	Name     string `json:"name,omitempty"`
+	Email    string `json:"email,omitempty"`
+	LocaleID int64  `json:"locale_id,omitempty"`
+}
+
+// Request is struct for end-user request payload
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/requests#json-format
+type Request struct {
+	ID              int64         `json:"id,omitempty"`
+	URL             string        `json:"url,omitempty"`
+	Subject         string        `json:"subject,omitempty"`
+	Description     string        `json:"description,omitempty"`
+	Status          string        `json:"status,omitempty"`
+	Priority        string        `json:"priority,omitempty"`
+	Type            string        `json:"type,omitempty"`
+	RequesterID     int64         `json:"requester_id,omitempty"`
+	OrganizationID  int64         `json:"organization_id,omitempty"`
+	AssigneeID      int64         `json:"assignee_id,omitempty"`
+	GroupID         int64         `json:"group_id,omitempty"`
+	CollaboratorIDs []int64       `json:"collaborator_ids,omitempty"`
+	DueAt           *time.Time    `json:"due_at,omitempty"`
+	CanBeSolvedByMe bool          `json:"can_be_solved_by_me,omitempty"`
+	Solved          bool          `json:"solved,omitempty"`
+	TicketFormID    int64         `json:"ticket_form_id,omitempty"`
+	CustomFields    []CustomField `json:"custom_fields,omitempty"`
+	Via             *Via          `json:"via,omitempty"`
+	CreatedAt       *time.Time    `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time    `json:"updated_at,omitempty"`
+
+	// Requester is POST only. It is used to create a request for anonymous user
+	Requester *Requester `json:"requester,omitempty"`
+
+	// Comment is POST and PUT only
+	Comment *TicketComment `json:"comment,omitempty"`
+}
+
+// RequestListOptions is options for GetRequests
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/requests#list-requests
+type RequestListOptions struct {
+	PageOptions
+
+	// Status is comma-separated list of statuses, e.g. "hold,open"
+	Status string `url:"status,omitempty"`
+
+	// SortBy can take "updated_at" or "created_at"
+	SortBy string `url:"sort_by,omitempty"`
+
+	// SortOrder can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// RequestAPI an interface containing all request related methods
+type RequestAPI interface {
+	GetRequests(ctx context.Context, opts *RequestListOptions) ([]Request, Page, error)
+	GetRequest(ctx context.Context, requestID int64) (Request, error)
+	CreateRequest(ctx context.Context, request Request) (Request, error)
+	UpdateRequest(ctx context.Context, requestID int64, request Request) (Request, error)
+	GetRequestComments(ctx context.Context, requestID int64) ([]TicketComment, Page, error)
+}
+
+// GetRequests fetches request list
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/requests#list-requests
+func (z *Client) GetRequests(ctx context.Context, opts *RequestListOptions) ([]Request, Page, error) {
+	var data struct {
+		Requests []Request `json:"requests"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &RequestListOptions{}
+	}
+
+	u, err := addOptions("/requests.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Requests, data.Page, nil
+}
+
+// GetRequest gets a specified request
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/requests#show-request
+func (z *Client) GetRequest(ctx context.Context, requestID int64) (Request, error) {
+	var result struct {
+		Request Request `json:"request"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/requests/%d.json", requestID))
+	if err != nil {
+		return Request{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Request{}, err
+	}
+	return result.Request, nil
+}
+
+// CreateRequest creates a new request.
+// Set Requester to create a request on behalf of an anonymous user
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/requests#create-request
+func (z *Client) CreateRequest(ctx context.Context, request Request) (Request, error) {
+	var data, result struct {
+		Request Request `json:"request"`
+	}
+	data.Request = request
+
+	body, err := z.post(ctx, "/requests.json", data)
+	if err != nil {
+		return Request{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Request{}, err
+	}
+	return result.Request, nil
+}
+
+// UpdateRequest updates a specified request
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/requests#update-request
+func (z *Client) UpdateRequest(ctx context.Context, requestID int64, request Request) (Request, error) {
+	var data, result struct {
+		Request Request `json:"request"`
+	}
+	data.Request = request
+
+	body, err := z.put(ctx, fmt.Sprintf("/requests/%d.json", requestID), data)
+	if err != nil {
+		return Request{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Request{}, err
+	}
+	return result.Request, nil
+}
+
+// GetRequestComments gets a list of comment for a specified request
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/requests#listing-comments
+func (z *Client) GetRequestComments(ctx context.Context, requestID int64) ([]TicketComment, Page, error) {
+	var data struct {
+		Comments []TicketComment `json:"comments"`
+		Page
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/requests/%d/comments.json", requestID))
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Comments, data.Page, nil
+}
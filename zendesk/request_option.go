@@ -0,0 +1,50 @@
+package zendesk
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+)
+
+// RequestOption is a function which modifies a single API request.
+// It is applied after the client's common headers and authentication
+type RequestOption func(req *http.Request)
+
+// WithHeader sets HTTP header only to the request
+func WithHeader(key string, value string) RequestOption {
+	return func(req *http.Request) {
+		req.Header.Set(key, value)
+	}
+}
+
+// WithAccept sets Accept header only to the request
+func WithAccept(mediaType string) RequestOption {
+	return WithHeader("Accept", mediaType)
+}
+
+// WithOnBehalfOf makes the request on behalf of the specified user.
+// The value is email address of the user to impersonate
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/introduction#requests-on-behalf-of-end-users
+func WithOnBehalfOf(email string) RequestOption {
+	return WithHeader("X-On-Behalf-Of", email)
+}
+
+// WithIdempotencyKey sets Idempotency-Key header to the request.
+// Zendesk returns the result of the first request for retries with the same key,
+// so a create can be retried after a timeout without making a duplicate.
+// Use one key per logical create and pass the same key to each retry
+//
+// ref: https://developer.zendesk.com/api-reference/ticketing/introduction/#idempotency
+func WithIdempotencyKey(key string) RequestOption {
+	return WithHeader("Idempotency-Key", key)
+}
+
+// NewIdempotencyKey generates a random key for WithIdempotencyKey
+func NewIdempotencyKey() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
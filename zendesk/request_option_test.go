@@ -0,0 +1,94 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestWithHeader(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := r.Header.Get("X-Custom"); v != "foo" {
+			t.Fatalf(`X-Custom header expect "foo", but got "%s"`, v)
+		}
+		if v := r.Header.Get("Accept"); v != "application/xml" {
+			t.Fatalf(`Accept header expect "application/xml", but got "%s"`, v)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "groups.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.get(ctx, "/groups.json", WithHeader("X-Custom", "foo"), WithAccept("application/xml"))
+	if err != nil {
+		t.Fatalf("Failed to send request: %s", err)
+	}
+}
+
+func TestCreateTicketCommentOnBehalfOf(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := r.Header.Get("X-On-Behalf-Of"); v != "end.user@example.com" {
+			t.Fatalf(`X-On-Behalf-Of header expect "end.user@example.com", but got "%s"`, v)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	comment := NewPublicTicketComment("public comment", 12345)
+	err := client.CreateTicketComment(ctx, 2, comment, WithOnBehalfOf("end.user@example.com"))
+	if err != nil {
+		t.Fatalf("Failed to create ticket comment: %s", err)
+	}
+}
+
+func TestCreateTicketWithIdempotencyKey(t *testing.T) {
+	var keys []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		// The first attempt fails as if the response was lost
+		if len(keys) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	key, err := NewIdempotencyKey()
+	if err != nil {
+		t.Fatalf("Failed to generate idempotency key: %s", err)
+	}
+
+	ticket := Ticket{Subject: "My printer is on fire", Comment: TicketComment{Body: "The smoke is very colorful."}}
+	if _, err := client.CreateTicket(ctx, ticket, WithIdempotencyKey(key)); err == nil {
+		t.Fatal("expected the first attempt to fail")
+	}
+	if _, err := client.CreateTicket(ctx, ticket, WithIdempotencyKey(key)); err != nil {
+		t.Fatalf("Failed to retry creating ticket: %s", err)
+	}
+
+	if len(keys) != 2 {
+		t.Fatalf("expected 2 requests, but got %d", len(keys))
+	}
+	for _, k := range keys {
+		if k != key {
+			t.Fatalf(`Idempotency-Key header expect "%s", but got "%s"`, key, k)
+		}
+	}
+}
+
+func TestNewIdempotencyKey(t *testing.T) {
+	a, err := NewIdempotencyKey()
+	if err != nil {
+		t.Fatalf("Failed to generate idempotency key: %s", err)
+	}
+	b, _ := NewIdempotencyKey()
+
+	if len(a) != 32 || a == b {
+		t.Fatalf("expected unique 32 character keys, but got %s and %s", a, b)
+	}
+}
@@ -0,0 +1,123 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetRequests(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "requests.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	requests, _, err := client.GetRequests(ctx, &RequestListOptions{Status: "open,solved"})
+	if err != nil {
+		t.Fatalf("Failed to get requests: %s", err)
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("expected length of requests is 2, but got %d", len(requests))
+	}
+
+	request := requests[0]
+	if request.ID != 33 || request.RequesterID != 1462 || request.Status != "open" {
+		t.Fatalf("Request was not parsed as expected: %v", request)
+	}
+	if request.Via == nil || request.Via.Channel != "web" {
+		t.Fatalf("Request via was not parsed as expected: %v", request.Via)
+	}
+	if len(request.CustomFields) != 1 {
+		t.Fatalf("expected length of custom fields is 1, but got %d", len(request.CustomFields))
+	}
+}
+
+func TestGetRequest(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "request.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	request, err := client.GetRequest(ctx, 35)
+	if err != nil {
+		t.Fatalf("Failed to get request: %s", err)
+	}
+
+	if request.ID != 35 {
+		t.Fatalf("Returned request does not have the expected ID 35. Request id is %d", request.ID)
+	}
+}
+
+func TestCreateAnonymousRequest(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Request struct {
+				Requester map[string]interface{} `json:"requester"`
+				Subject   string                 `json:"subject"`
+			} `json:"request"`
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("Failed to parse request body: %s", err)
+		}
+		if data.Request.Requester["name"] != "Anonymous customer" || data.Request.Requester["email"] != "anon@example.com" {
+			t.Fatalf("requester object was not sent as expected: %s", body)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "request.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	request, err := client.CreateRequest(ctx, Request{
+		Subject: "Help!",
+		Requester: &Requester{
+			Name:  "Anonymous customer",
+			Email: "anon@example.com",
+		},
+		Comment: &TicketComment{
+			Body: "My printer is on fire!",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create request: %s", err)
+	}
+
+	if request.RequesterID != 1463 {
+		t.Fatalf("Returned request does not have the expected requester ID. Requester id is %d", request.RequesterID)
+	}
+}
+
+func TestUpdateRequest(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "request.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateRequest(ctx, 35, Request{
+		Comment: &TicketComment{
+			Body: "Thanks!",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to update request: %s", err)
+	}
+}
+
+func TestGetRequestComments(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "request_comments.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	comments, _, err := client.GetRequestComments(ctx, 33)
+	if err != nil {
+		t.Fatalf("Failed to get request comments: %s", err)
+	}
+
+	if len(comments) != 1 || comments[0].AuthorID != 1462 {
+		t.Fatalf("Request comments were not parsed as expected: %v", comments)
+	}
+}
@@ -0,0 +1,76 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// SatisfactionRating is struct for satisfaction rating payload.
+// Score can be "offered", "unoffered", "good" or "bad"
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/satisfaction_ratings#json-format
+type SatisfactionRating struct {
+	ID          int64      `json:"id,omitempty"`
+	URL         string     `json:"url,omitempty"`
+	AssigneeID  int64      `json:"assignee_id,omitempty"`
+	GroupID     int64      `json:"group_id,omitempty"`
+	RequesterID int64      `json:"requester_id,omitempty"`
+	TicketID    int64      `json:"ticket_id,omitempty"`
+	Score       string     `json:"score,omitempty"`
+	Comment     string     `json:"comment,omitempty"`
+	Reason      string     `json:"reason,omitempty"`
+	ReasonID    int64      `json:"reason_id,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+// SatisfactionRatingListOptions is options for GetSatisfactionRatings
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/satisfaction_ratings#filters
+type SatisfactionRatingListOptions struct {
+	PageOptions
+
+	// Score filters ratings by score, e.g. "received", "good", "bad" or "offered"
+	Score string `url:"score,omitempty"`
+
+	// StartTime and EndTime are UNIX timestamps to filter ratings by created_at
+	StartTime int64 `url:"start_time,omitempty"`
+	EndTime   int64 `url:"end_time,omitempty"`
+}
+
+// SatisfactionRatingAPI an interface containing all satisfaction rating related methods
+type SatisfactionRatingAPI interface {
+	GetSatisfactionRatings(ctx context.Context, opts *SatisfactionRatingListOptions) ([]SatisfactionRating, Page, error)
+}
+
+// GetSatisfactionRatings fetches satisfaction rating list
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/satisfaction_ratings#list-satisfaction-ratings
+func (z *Client) GetSatisfactionRatings(ctx context.Context, opts *SatisfactionRatingListOptions) ([]SatisfactionRating, Page, error) {
+	var data struct {
+		SatisfactionRatings []SatisfactionRating `json:"satisfaction_ratings"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &SatisfactionRatingListOptions{}
+	}
+
+	u, err := addOptions("/satisfaction_ratings.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.SatisfactionRatings, data.Page, nil
+}
@@ -0,0 +1,35 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetSatisfactionRatings(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("score") != "received" || q.Get("start_time") != "1585699200" {
+			t.Fatalf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "satisfaction_ratings.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ratings, _, err := client.GetSatisfactionRatings(ctx, &SatisfactionRatingListOptions{
+		Score:     "received",
+		StartTime: 1585699200,
+	})
+	if err != nil {
+		t.Fatalf("Failed to get satisfaction ratings: %s", err)
+	}
+
+	if len(ratings) != 2 {
+		t.Fatalf("expected length of satisfaction ratings is 2, but got %d", len(ratings))
+	}
+	if r := ratings[1]; r.Score != "bad" || r.Comment != "Took too long" || r.ReasonID != 35121 {
+		t.Fatalf("Satisfaction rating was not parsed as expected: %v", r)
+	}
+}
@@ -0,0 +1,69 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// SatisfactionReason is struct for satisfaction reason payload.
+// ID corresponds to reason_id of satisfaction ratings
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/satisfaction_reasons#json-format
+type SatisfactionReason struct {
+	ID         int64      `json:"id,omitempty"`
+	URL        string     `json:"url,omitempty"`
+	ReasonCode int64      `json:"reason_code,omitempty"`
+	Value      string     `json:"value,omitempty"`
+	RawValue   string     `json:"raw_value,omitempty"`
+	Deleted    bool       `json:"deleted,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+// SatisfactionReasonAPI an interface containing all satisfaction reason related methods
+type SatisfactionReasonAPI interface {
+	GetSatisfactionRatingReasons(ctx context.Context) ([]SatisfactionReason, error)
+	GetSatisfactionRatingReason(ctx context.Context, id int64) (SatisfactionReason, error)
+}
+
+// GetSatisfactionRatingReasons fetches satisfaction reason list
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/satisfaction_reasons#list-reasons-for-satisfaction-rating
+func (z *Client) GetSatisfactionRatingReasons(ctx context.Context) ([]SatisfactionReason, error) {
+	var data struct {
+		Reasons []SatisfactionReason `json:"reasons"`
+	}
+
+	body, err := z.get(ctx, "/satisfaction_reasons.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, err
+	}
+	return data.Reasons, nil
+}
+
+// GetSatisfactionRatingReason gets a specified satisfaction reason
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/satisfaction_reasons#show-reason-for-satisfaction-rating
+func (z *Client) GetSatisfactionRatingReason(ctx context.Context, id int64) (SatisfactionReason, error) {
+	var result struct {
+		Reason SatisfactionReason `json:"reason"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/satisfaction_reasons/%d.json", id))
+	if err != nil {
+		return SatisfactionReason{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SatisfactionReason{}, err
+	}
+	return result.Reason, nil
+}
@@ -0,0 +1,40 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetSatisfactionRatingReasons(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "satisfaction_reasons.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	reasons, err := client.GetSatisfactionRatingReasons(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get satisfaction reasons: %s", err)
+	}
+
+	if len(reasons) != 2 {
+		t.Fatalf("expected length of satisfaction reasons is 2, but got %d", len(reasons))
+	}
+	if reasons[1].ReasonCode != 5 || reasons[1].RawValue != "{{dc.issue_took_too_long}}" {
+		t.Fatalf("Satisfaction reason was not parsed as expected: %v", reasons[1])
+	}
+}
+
+func TestGetSatisfactionRatingReason(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "satisfaction_reason.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	reason, err := client.GetSatisfactionRatingReason(ctx, 35125)
+	if err != nil {
+		t.Fatalf("Failed to get satisfaction reason: %s", err)
+	}
+
+	expectedID := int64(35125)
+	if reason.ID != expectedID {
+		t.Fatalf("Returned satisfaction reason does not have the expected ID %d. Reason id is %d", expectedID, reason.ID)
+	}
+}
@@ -0,0 +1,233 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// maxScheduleDays is how far business time is searched before giving up,
+// which stops schedules without open intervals from looping forever
+const maxScheduleDays = 2 * 366
+
+const minutesPerDay = 24 * 60
+
+// ScheduleInterval is a weekly business hours interval.
+// StartTime and EndTime are minutes from Sunday 00:00 in the time zone of the schedule
+type ScheduleInterval struct {
+	StartTime int `json:"start_time"`
+	EndTime   int `json:"end_time"`
+}
+
+// Holiday is a holiday of a schedule. StartDate and EndDate are inclusive
+// dates in the form of "2006-01-02"
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/schedules#holidays
+type Holiday struct {
+	ID        int64  `json:"id,omitempty"`
+	Name      string `json:"name"`
+	StartDate string `json:"start_date"`
+	EndDate   string `json:"end_date"`
+}
+
+// Schedule is struct for business hours schedule payload.
+// Holidays aren't part of the schedule payload, and are filled by GetScheduleHolidays
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/schedules
+type Schedule struct {
+	ID        int64              `json:"id,omitempty"`
+	Name      string             `json:"name"`
+	TimeZone  string             `json:"time_zone"`
+	Intervals []ScheduleInterval `json:"intervals"`
+	Holidays  []Holiday          `json:"holidays,omitempty"`
+	CreatedAt time.Time          `json:"created_at,omitempty"`
+	UpdatedAt time.Time          `json:"updated_at,omitempty"`
+
+	// Location is used for business time calculation instead of TimeZone.
+	// Zendesk names time zones like "Eastern Time (US & Canada)", which
+	// time.LoadLocation doesn't know, so set it for such schedules.
+	// It falls back to TimeZone, and then to UTC
+	Location *time.Location `json:"-"`
+}
+
+// ScheduleAPI an interface containing business hours schedule methods
+type ScheduleAPI interface {
+	GetSchedules(ctx context.Context) ([]Schedule, error)
+	GetSchedule(ctx context.Context, id int64) (Schedule, error)
+	GetScheduleHolidays(ctx context.Context, id int64) ([]Holiday, error)
+}
+
+// GetSchedules fetches business hours schedules
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/schedules#list-schedules
+func (z *Client) GetSchedules(ctx context.Context) ([]Schedule, error) {
+	var data struct {
+		Schedules []Schedule `json:"schedules"`
+	}
+
+	body, err := z.get(ctx, "/business_hours/schedules.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, err
+	}
+	return data.Schedules, nil
+}
+
+// GetSchedule fetches a business hours schedule with its holidays
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/schedules#show-schedule
+func (z *Client) GetSchedule(ctx context.Context, id int64) (Schedule, error) {
+	var result struct {
+		Schedule Schedule `json:"schedule"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/business_hours/schedules/%d.json", id))
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	holidays, err := z.GetScheduleHolidays(ctx, id)
+	if err != nil {
+		return Schedule{}, err
+	}
+	result.Schedule.Holidays = holidays
+
+	return result.Schedule, nil
+}
+
+// GetScheduleHolidays fetches holidays of a business hours schedule
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/schedules#list-holidays-for-a-schedule
+func (z *Client) GetScheduleHolidays(ctx context.Context, id int64) ([]Holiday, error) {
+	var data struct {
+		Holidays []Holiday `json:"holidays"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/business_hours/schedules/%d/holidays.json", id))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, err
+	}
+	return data.Holidays, nil
+}
+
+func (s Schedule) location() *time.Location {
+	if s.Location != nil {
+		return s.Location
+	}
+	if s.TimeZone != "" {
+		if loc, err := time.LoadLocation(s.TimeZone); err == nil {
+			return loc
+		}
+	}
+	return time.UTC
+}
+
+// isHoliday reports whether the local date of day is in a holiday
+func (s Schedule) isHoliday(day time.Time) bool {
+	date := day.Format("2006-01-02")
+	for _, h := range s.Holidays {
+		// Dates in the same format compare in calendar order
+		if h.StartDate <= date && date <= h.EndDate {
+			return true
+		}
+	}
+	return false
+}
+
+// eachBusinessPeriod calls fn with each open period of the schedule from from
+// in order, until fn returns false. It returns false if the search gave up
+func (s Schedule) eachBusinessPeriod(from time.Time, fn func(start, end time.Time) bool) bool {
+	loc := s.location()
+	local := from.In(loc)
+
+	intervals := make([]ScheduleInterval, len(s.Intervals))
+	copy(intervals, s.Intervals)
+	sort.Slice(intervals, func(i, j int) bool { return intervals[i].StartTime < intervals[j].StartTime })
+
+	for i := 0; i < maxScheduleDays; i++ {
+		day := time.Date(local.Year(), local.Month(), local.Day()+i, 0, 0, 0, 0, loc)
+		if s.isHoliday(day) {
+			continue
+		}
+
+		dayStart := int(day.Weekday()) * minutesPerDay
+		dayEnd := dayStart + minutesPerDay
+		for _, iv := range intervals {
+			startMin, endMin := iv.StartTime, iv.EndTime
+			if startMin < dayStart {
+				startMin = dayStart
+			}
+			if endMin > dayEnd {
+				endMin = dayEnd
+			}
+			if startMin >= endMin {
+				continue
+			}
+
+			// time.Date normalizes the minutes, which keeps DST days right
+			start := time.Date(day.Year(), day.Month(), day.Day(), 0, startMin-dayStart, 0, 0, loc)
+			end := time.Date(day.Year(), day.Month(), day.Day(), 0, endMin-dayStart, 0, 0, loc)
+			if !end.After(from) {
+				continue
+			}
+			if start.Before(from) {
+				start = from
+			}
+			if !fn(start, end) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// addBusinessTime returns the time when d of business time has passed since from.
+// It returns false if the schedule has no business time to spend
+func (s Schedule) addBusinessTime(from time.Time, d time.Duration) (time.Time, bool) {
+	if d <= 0 {
+		return from, true
+	}
+
+	var at time.Time
+	ok := s.eachBusinessPeriod(from, func(start, end time.Time) bool {
+		if period := end.Sub(start); period < d {
+			d -= period
+			return true
+		}
+		at = start.Add(d)
+		return false
+	})
+	return at, ok
+}
+
+// businessTimeBetween returns the business time from a to b
+func (s Schedule) businessTimeBetween(a, b time.Time) time.Duration {
+	var total time.Duration
+	s.eachBusinessPeriod(a, func(start, end time.Time) bool {
+		if !start.Before(b) {
+			return false
+		}
+		if end.After(b) {
+			end = b
+		}
+		total += end.Sub(start)
+		return true
+	})
+	return total
+}
@@ -0,0 +1,54 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetSchedules(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/business_hours/schedules.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		w.Write([]byte(`{"schedules":[{"id":1,"name":"Support","time_zone":"UTC","intervals":[{"start_time":1980,"end_time":2460}]}]}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	schedules, err := client.GetSchedules(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get schedules: %s", err)
+	}
+
+	if len(schedules) != 1 {
+		t.Fatalf("expected length of schedules is 1, but got %d", len(schedules))
+	}
+	if iv := schedules[0].Intervals[0]; iv.StartTime != 1980 || iv.EndTime != 2460 {
+		t.Fatalf("unexpected interval %v", iv)
+	}
+}
+
+func TestGetSchedule(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/business_hours/schedules/1.json":
+			w.Write([]byte(`{"schedule":{"id":1,"name":"Support","time_zone":"UTC","intervals":[{"start_time":1980,"end_time":2460}]}}`))
+		case "/business_hours/schedules/1/holidays.json":
+			w.Write([]byte(`{"holidays":[{"id":7,"name":"New Year","start_date":"2021-01-01","end_date":"2021-01-01"}]}`))
+		default:
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	schedule, err := client.GetSchedule(ctx, 1)
+	if err != nil {
+		t.Fatalf("Failed to get schedule: %s", err)
+	}
+
+	if len(schedule.Holidays) != 1 || schedule.Holidays[0].StartDate != "2021-01-01" {
+		t.Fatalf("expected the schedule to have its holiday, but got %v", schedule.Holidays)
+	}
+}
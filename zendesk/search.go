@@ -16,12 +16,41 @@ type SearchOptions struct {
 	SortOrder string `url:"sort_order,omitempty"`
 }
 
+// SearchExportOptions are the options that can be provided to the search export API
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/search#export-search-results
+type SearchExportOptions struct {
+	Query string `url:"query"`
+
+	// FilterType is required. It can take "ticket", "organization", "user" or "group"
+	FilterType string `url:"filter[type]"`
+
+	// PageSize is the number of results per page. The maximum is 1000
+	PageSize int `url:"page[size],omitempty"`
+
+	// PageAfter is the cursor returned by the previous page
+	PageAfter string `url:"page[after],omitempty"`
+}
+
 type SearchAPI interface {
 	Search(ctx context.Context, opts *SearchOptions) (SearchResults, Page, error)
+	SearchTickets(ctx context.Context, query string, opts *SearchOptions) ([]Ticket, Page, error)
+	SearchOrganizations(ctx context.Context, query string, opts *SearchOptions) ([]Organization, Page, error)
+	SearchExport(ctx context.Context, opts *SearchExportOptions) (SearchResults, string, bool, error)
+	SearchCount(ctx context.Context, query string) (int64, error)
 }
 
+// SearchResults is the results of search API.
+// In addition to the mixed list, each result is stored to
+// the typed slice of its result_type
 type SearchResults struct {
 	results []interface{}
+
+	Tickets       []Ticket
+	Users         []User
+	Groups        []Group
+	Organizations []Organization
+	Topics        []Topic
 }
 
 func (r *SearchResults) MarshalJSON() ([]byte, error) {
@@ -30,7 +59,7 @@ func (r *SearchResults) MarshalJSON() ([]byte, error) {
 
 func (r *SearchResults) UnmarshalJSON(b []byte) error {
 	var (
-		results []interface{}
+		results SearchResults
 		tmp     []json.RawMessage
 	)
 
@@ -45,10 +74,22 @@ func (r *SearchResults) UnmarshalJSON(b []byte) error {
 			return err
 		}
 
-		results = append(results, value)
+		results.results = append(results.results, value)
+		switch o := value.(type) {
+		case Ticket:
+			results.Tickets = append(results.Tickets, o)
+		case User:
+			results.Users = append(results.Users, o)
+		case Group:
+			results.Groups = append(results.Groups, o)
+		case Organization:
+			results.Organizations = append(results.Organizations, o)
+		case Topic:
+			results.Topics = append(results.Topics, o)
+		}
 	}
 
-	r.results = results
+	*r = results
 
 	return nil
 }
@@ -140,3 +181,104 @@ func (z *Client) Search(ctx context.Context, opts *SearchOptions) (SearchResults
 
 	return data.Results, data.Page, nil
 }
+
+// SearchTickets searches tickets with the query. "type:ticket" is added to the query,
+// and Query of opts is ignored
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/search
+func (z *Client) SearchTickets(ctx context.Context, query string, opts *SearchOptions) ([]Ticket, Page, error) {
+	results, page, err := z.searchType(ctx, "ticket", query, opts)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	return results.Tickets, page, nil
+}
+
+// SearchOrganizations searches organizations with the query. "type:organization" is added to the query,
+// and Query of opts is ignored
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/search
+func (z *Client) SearchOrganizations(ctx context.Context, query string, opts *SearchOptions) ([]Organization, Page, error) {
+	results, page, err := z.searchType(ctx, "organization", query, opts)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	return results.Organizations, page, nil
+}
+
+// searchType searches resources of the specified type
+func (z *Client) searchType(ctx context.Context, resultType string, query string, opts *SearchOptions) (SearchResults, Page, error) {
+	tmp := SearchOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	tmp.Query = fmt.Sprintf("type:%s %s", resultType, query)
+
+	return z.Search(ctx, &tmp)
+}
+
+// SearchExport exports the search results with cursor based pagination.
+// It returns the cursor of next page and whether there are more results
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/search#export-search-results
+func (z *Client) SearchExport(ctx context.Context, opts *SearchExportOptions) (SearchResults, string, bool, error) {
+	var data struct {
+		Results SearchResults `json:"results"`
+		Meta    struct {
+			HasMore     bool   `json:"has_more"`
+			AfterCursor string `json:"after_cursor"`
+		} `json:"meta"`
+	}
+
+	if opts == nil {
+		return SearchResults{}, "", false, &OptionsError{opts}
+	}
+
+	u, err := addOptions("/search/export.json", opts)
+	if err != nil {
+		return SearchResults{}, "", false, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return SearchResults{}, "", false, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return SearchResults{}, "", false, err
+	}
+
+	return data.Results, data.Meta.AfterCursor, data.Meta.HasMore, nil
+}
+
+// SearchCount returns the number of search results of the query
+// without fetching the results
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/search#show-results-count
+func (z *Client) SearchCount(ctx context.Context, query string) (int64, error) {
+	var data struct {
+		Count int64 `json:"count"`
+	}
+
+	u, err := addOptions("/search/count.json", &struct {
+		Query string `url:"query"`
+	}{query})
+	if err != nil {
+		return 0, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return 0, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return 0, err
+	}
+
+	return data.Count, nil
+}
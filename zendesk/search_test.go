@@ -120,3 +120,134 @@ func TestSearchQueryParam(t *testing.T) {
 		t.Fatalf("Received error from search api")
 	}
 }
+
+func TestSearchMixedResults(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "search_mixed.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	results, page, err := client.Search(ctx, &SearchOptions{Query: "nyan"})
+	if err != nil {
+		t.Fatalf("Failed to get search results: %s", err)
+	}
+
+	if len(results.List()) != 4 {
+		t.Fatalf("expected length of results is 4, but got %d", len(results.List()))
+	}
+	if len(results.Tickets) != 1 || results.Tickets[0].ID != 4 {
+		t.Fatalf("Tickets were not populated as expected: %v", results.Tickets)
+	}
+	if len(results.Organizations) != 1 || results.Organizations[0].ID != 360363695492 {
+		t.Fatalf("Organizations were not populated as expected: %v", results.Organizations)
+	}
+	if len(results.Users) != 1 || results.Users[0].ID != 1234 {
+		t.Fatalf("Users were not populated as expected: %v", results.Users)
+	}
+	if len(results.Groups) != 1 || results.Groups[0].ID != 360007194452 {
+		t.Fatalf("Groups were not populated as expected: %v", results.Groups)
+	}
+	if !page.HasNext() {
+		t.Fatal("Page should have next page")
+	}
+}
+
+func TestSearchTicketsTyped(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if v := q.Get("query"); v != "type:ticket status:open" {
+			t.Fatalf(`query expect "type:ticket status:open", but got "%s"`, v)
+		}
+		if q.Get("sort_by") != "created_at" || q.Get("sort_order") != "desc" {
+			t.Fatalf("Unexpected sort params %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "search_ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, _, err := client.SearchTickets(ctx, "status:open", &SearchOptions{
+		SortBy:    "created_at",
+		SortOrder: "desc",
+	})
+	if err != nil {
+		t.Fatalf("Failed to search tickets: %s", err)
+	}
+
+	if len(tickets) != 1 || tickets[0].ID != 4 {
+		t.Fatalf("Tickets were not returned as expected: %v", tickets)
+	}
+}
+
+func TestSearchOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if v := r.URL.Query().Get("query"); v != "type:organization nyan" {
+			t.Fatalf(`query expect "type:organization nyan", but got "%s"`, v)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "search_mixed.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	orgs, _, err := client.SearchOrganizations(ctx, "nyan", nil)
+	if err != nil {
+		t.Fatalf("Failed to search organizations: %s", err)
+	}
+
+	if len(orgs) != 1 || orgs[0].Name != "nukosuke" {
+		t.Fatalf("Organizations were not returned as expected: %v", orgs)
+	}
+}
+
+func TestSearchExport(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/search/export.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		if q.Get("filter[type]") != "ticket" || q.Get("page[size]") != "100" || q.Get("query") != "nyan" {
+			t.Fatalf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "search_export.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	results, cursor, hasMore, err := client.SearchExport(ctx, &SearchExportOptions{
+		Query:      "nyan",
+		FilterType: "ticket",
+		PageSize:   100,
+	})
+	if err != nil {
+		t.Fatalf("Failed to export search results: %s", err)
+	}
+
+	if len(results.Tickets) != 1 {
+		t.Fatalf("expected length of tickets is 1, but got %d", len(results.Tickets))
+	}
+	if !hasMore || cursor == "" {
+		t.Fatalf("Cursor was not returned as expected. has_more=%v cursor=%s", hasMore, cursor)
+	}
+}
+
+func TestSearchCount(t *testing.T) {
+	expected := "type:ticket status:open"
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/search/count.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		if q := r.URL.Query().Get("query"); q != expected {
+			t.Fatalf(`Did not get the expect query string: "%s". Was: "%s"`, expected, q)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "search_count.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	count, err := client.SearchCount(ctx, expected)
+	if err != nil {
+		t.Fatalf("Failed to get search count: %s", err)
+	}
+	if count != 12 {
+		t.Fatalf("count expect 12, but got %d", count)
+	}
+}
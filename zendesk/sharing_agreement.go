@@ -0,0 +1,127 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// SharingAgreement is struct for ticket sharing agreement payload
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/sharing_agreements#json-format
+type SharingAgreement struct {
+	ID              int64      `json:"id,omitempty"`
+	URL             string     `json:"url,omitempty"`
+	Name            string     `json:"name,omitempty"`
+	Type            string     `json:"type,omitempty"`
+	Status          string     `json:"status,omitempty"`
+	PartnerName     string     `json:"partner_name,omitempty"`
+	RemoteSubdomain string     `json:"remote_subdomain,omitempty"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time `json:"updated_at,omitempty"`
+}
+
+// SharingAgreementAPI an interface containing all sharing agreement related methods
+type SharingAgreementAPI interface {
+	GetSharingAgreements(ctx context.Context) ([]SharingAgreement, error)
+	GetSharingAgreement(ctx context.Context, id int64) (SharingAgreement, error)
+	CreateSharingAgreement(ctx context.Context, agreement SharingAgreement) (SharingAgreement, error)
+	UpdateSharingAgreement(ctx context.Context, id int64, agreement SharingAgreement) (SharingAgreement, error)
+	DeleteSharingAgreement(ctx context.Context, id int64) error
+}
+
+// GetSharingAgreements fetches sharing agreement list
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/sharing_agreements#list-sharing-agreements
+func (z *Client) GetSharingAgreements(ctx context.Context) ([]SharingAgreement, error) {
+	var data struct {
+		SharingAgreements []SharingAgreement `json:"sharing_agreements"`
+	}
+
+	body, err := z.get(ctx, "/sharing_agreements.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, err
+	}
+	return data.SharingAgreements, nil
+}
+
+// GetSharingAgreement gets a specified sharing agreement
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/sharing_agreements#show-a-sharing-agreement
+func (z *Client) GetSharingAgreement(ctx context.Context, id int64) (SharingAgreement, error) {
+	var result struct {
+		SharingAgreement SharingAgreement `json:"sharing_agreement"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/sharing_agreements/%d.json", id))
+	if err != nil {
+		return SharingAgreement{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SharingAgreement{}, err
+	}
+	return result.SharingAgreement, nil
+}
+
+// CreateSharingAgreement creates new sharing agreement
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/sharing_agreements#create-sharing-agreement
+func (z *Client) CreateSharingAgreement(ctx context.Context, agreement SharingAgreement) (SharingAgreement, error) {
+	var data, result struct {
+		SharingAgreement SharingAgreement `json:"sharing_agreement"`
+	}
+	data.SharingAgreement = agreement
+
+	body, err := z.post(ctx, "/sharing_agreements.json", data)
+	if err != nil {
+		return SharingAgreement{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SharingAgreement{}, err
+	}
+	return result.SharingAgreement, nil
+}
+
+// UpdateSharingAgreement updates the specified sharing agreement.
+// Only Status can be updated
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/sharing_agreements#update-a-sharing-agreement
+func (z *Client) UpdateSharingAgreement(ctx context.Context, id int64, agreement SharingAgreement) (SharingAgreement, error) {
+	var data, result struct {
+		SharingAgreement SharingAgreement `json:"sharing_agreement"`
+	}
+	data.SharingAgreement = agreement
+
+	body, err := z.put(ctx, fmt.Sprintf("/sharing_agreements/%d.json", id), data)
+	if err != nil {
+		return SharingAgreement{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SharingAgreement{}, err
+	}
+	return result.SharingAgreement, nil
+}
+
+// DeleteSharingAgreement deletes the specified sharing agreement
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/sharing_agreements#delete-a-sharing-agreement
+func (z *Client) DeleteSharingAgreement(ctx context.Context, id int64) error {
+	err := z.delete(ctx, fmt.Sprintf("/sharing_agreements/%d.json", id))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetSharingAgreements(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "sharing_agreements.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	agreements, err := client.GetSharingAgreements(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get sharing agreements: %s", err)
+	}
+
+	if len(agreements) != 2 {
+		t.Fatalf("expected length of sharing agreements is 2, but got %d", len(agreements))
+	}
+}
+
+func TestGetSharingAgreement(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "sharing_agreement.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	agreement, err := client.GetSharingAgreement(ctx, 88335)
+	if err != nil {
+		t.Fatalf("Failed to get sharing agreement: %s", err)
+	}
+
+	if agreement.Type != "inbound" || agreement.Status != "accepted" || agreement.RemoteSubdomain != "partner" {
+		t.Fatalf("Sharing agreement was not parsed as expected: %v", agreement)
+	}
+}
+
+func TestCreateSharingAgreement(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodGet, "sharing_agreement.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	agreement, err := client.CreateSharingAgreement(ctx, SharingAgreement{
+		RemoteSubdomain: "partner",
+	})
+	if err != nil {
+		t.Fatalf("Failed to create sharing agreement: %s", err)
+	}
+
+	if agreement.ID != 88335 {
+		t.Fatalf("Returned sharing agreement does not have the expected ID 88335. Sharing agreement id is %d", agreement.ID)
+	}
+}
+
+func TestUpdateSharingAgreement(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "sharing_agreement.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.UpdateSharingAgreement(ctx, 88335, SharingAgreement{
+		Status: "accepted",
+	})
+	if err != nil {
+		t.Fatalf("Failed to update sharing agreement: %s", err)
+	}
+}
+
+func TestDeleteSharingAgreement(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/sharing_agreements/88335.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteSharingAgreement(ctx, 88335); err != nil {
+		t.Fatalf("Failed to delete sharing agreement: %s", err)
+	}
+}
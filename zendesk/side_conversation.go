@@ -0,0 +1,140 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// SideConversationParticipant is a participant of side conversation.
+// Which fields are set depends on the channel of the participant
+type SideConversationParticipant struct {
+	UserID           int64  `json:"user_id,omitempty"`
+	Name             string `json:"name,omitempty"`
+	Email            string `json:"email,omitempty"`
+	SlackWorkspaceID string `json:"slack_workspace_id,omitempty"`
+	SlackChannelID   string `json:"slack_channel_id,omitempty"`
+	SupportGroupID   int64  `json:"support_group_id,omitempty"`
+	SupportAgentID   int64  `json:"support_agent_id,omitempty"`
+}
+
+// SideConversation is struct for side conversation payload
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/side_conversation#json-format
+type SideConversation struct {
+	ID             string                        `json:"id"`
+	URL            string                        `json:"url"`
+	TicketID       int64                         `json:"ticket_id"`
+	Subject        string                        `json:"subject"`
+	PreviewText    string                        `json:"preview_text"`
+	State          string                        `json:"state"`
+	Participants   []SideConversationParticipant `json:"participants"`
+	CreatedAt      time.Time                     `json:"created_at"`
+	UpdatedAt      time.Time                     `json:"updated_at"`
+	MessageAddedAt time.Time                     `json:"message_added_at"`
+}
+
+// SideConversationMessage is a message to create or reply to side conversation
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/side_conversation#create-side-conversation
+type SideConversationMessage struct {
+	Subject string `json:"subject,omitempty"`
+	Body    string `json:"body,omitempty"`
+
+	// HTMLBody is used instead of Body when it is set
+	HTMLBody string `json:"html_body,omitempty"`
+
+	// Channel can take "email" or "slack"
+	Channel string `json:"channel,omitempty"`
+
+	To []SideConversationParticipant `json:"to,omitempty"`
+
+	// Uploads is list of upload tokens to attach
+	Uploads []string `json:"uploads,omitempty"`
+}
+
+// SideConversationAPI an interface containing all side conversation related methods
+type SideConversationAPI interface {
+	GetSideConversations(ctx context.Context, ticketID int64) ([]SideConversation, Page, error)
+	GetSideConversation(ctx context.Context, ticketID int64, sideConversationID string) (SideConversation, error)
+	CreateSideConversation(ctx context.Context, ticketID int64, message SideConversationMessage) (SideConversation, error)
+	ReplySideConversation(ctx context.Context, ticketID int64, sideConversationID string, message SideConversationMessage) (SideConversation, error)
+}
+
+// GetSideConversations fetches side conversation list of the ticket
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/side_conversation#list-side-conversations
+func (z *Client) GetSideConversations(ctx context.Context, ticketID int64) ([]SideConversation, Page, error) {
+	var data struct {
+		SideConversations []SideConversation `json:"side_conversations"`
+		Page
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/tickets/%d/side_conversations.json", ticketID))
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.SideConversations, data.Page, nil
+}
+
+// GetSideConversation gets a specified side conversation of the ticket
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/side_conversation#show-side-conversation
+func (z *Client) GetSideConversation(ctx context.Context, ticketID int64, sideConversationID string) (SideConversation, error) {
+	var result struct {
+		SideConversation SideConversation `json:"side_conversation"`
+	}
+
+	body, err := z.get(ctx, fmt.Sprintf("/tickets/%d/side_conversations/%s.json", ticketID, sideConversationID))
+	if err != nil {
+		return SideConversation{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SideConversation{}, err
+	}
+	return result.SideConversation, nil
+}
+
+// CreateSideConversation creates new side conversation on the ticket
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/side_conversation#create-side-conversation
+func (z *Client) CreateSideConversation(ctx context.Context, ticketID int64, message SideConversationMessage) (SideConversation, error) {
+	return z.postSideConversationMessage(ctx, fmt.Sprintf("/tickets/%d/side_conversations.json", ticketID), message)
+}
+
+// ReplySideConversation adds a reply message to the side conversation
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/side_conversation#reply-to-side-conversation
+func (z *Client) ReplySideConversation(ctx context.Context, ticketID int64, sideConversationID string, message SideConversationMessage) (SideConversation, error) {
+	return z.postSideConversationMessage(ctx, fmt.Sprintf("/tickets/%d/side_conversations/%s/reply.json", ticketID, sideConversationID), message)
+}
+
+// postSideConversationMessage posts the message to the path and returns the side conversation
+func (z *Client) postSideConversationMessage(ctx context.Context, path string, message SideConversationMessage) (SideConversation, error) {
+	var data struct {
+		Message SideConversationMessage `json:"message"`
+	}
+	var result struct {
+		SideConversation SideConversation `json:"side_conversation"`
+	}
+	data.Message = message
+
+	body, err := z.post(ctx, path, data)
+	if err != nil {
+		return SideConversation{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return SideConversation{}, err
+	}
+	return result.SideConversation, nil
+}
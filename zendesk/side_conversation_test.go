@@ -0,0 +1,109 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetSideConversations(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "side_conversations.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	sideConversations, _, err := client.GetSideConversations(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to get side conversations: %s", err)
+	}
+
+	if len(sideConversations) != 1 {
+		t.Fatalf("expected length of side conversations is 1, but got %d", len(sideConversations))
+	}
+
+	sc := sideConversations[0]
+	if sc.TicketID != 2 || sc.State != "open" {
+		t.Fatalf("Side conversation was not parsed as expected: %v", sc)
+	}
+	if len(sc.Participants) != 2 || sc.Participants[1].Email != "engineer@example.org" {
+		t.Fatalf("Side conversation participants were not parsed as expected: %v", sc.Participants)
+	}
+}
+
+func TestGetSideConversation(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tickets/2/side_conversations/8566255a-4540-11ea-8d47-a3d7a0858a01.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPost, "side_conversation.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	sc, err := client.GetSideConversation(ctx, 2, "8566255a-4540-11ea-8d47-a3d7a0858a01")
+	if err != nil {
+		t.Fatalf("Failed to get side conversation: %s", err)
+	}
+
+	if sc.Subject != "Can you help with this?" {
+		t.Fatalf("Side conversation was not parsed as expected: %v", sc)
+	}
+}
+
+func TestCreateSideConversation(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Message SideConversationMessage `json:"message"`
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &data); err != nil {
+			t.Fatalf("Failed to parse request body: %s", err)
+		}
+		if data.Message.Channel != "email" || len(data.Message.To) != 1 || data.Message.To[0].Email != "engineer@example.org" {
+			t.Fatalf("message was not sent as expected: %s", body)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "side_conversation.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	sc, err := client.CreateSideConversation(ctx, 2, SideConversationMessage{
+		Subject: "Can you help with this?",
+		Body:    "The customer's printer is on fire",
+		Channel: "email",
+		To: []SideConversationParticipant{
+			{Email: "engineer@example.org"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create side conversation: %s", err)
+	}
+
+	if sc.ID != "8566255a-4540-11ea-8d47-a3d7a0858a01" {
+		t.Fatalf("Returned side conversation does not have the expected ID. Side conversation id is %s", sc.ID)
+	}
+}
+
+func TestReplySideConversation(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tickets/2/side_conversations/8566255a-4540-11ea-8d47-a3d7a0858a01/reply.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "side_conversation.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.ReplySideConversation(ctx, 2, "8566255a-4540-11ea-8d47-a3d7a0858a01", SideConversationMessage{
+		Body: "Thanks!",
+	})
+	if err != nil {
+		t.Fatalf("Failed to reply side conversation: %s", err)
+	}
+}
@@ -2,9 +2,13 @@ package zendesk
 
 import (
 	"strings"
+
+	"github.com/tylerconlee/zendesk-go/zendesk/sideload"
 )
 
 type includeBuilder struct {
+	// base is the API which the path belongs to. The zero value is Support API
+	base apiBase
 	keys []string
 }
 
@@ -45,5 +49,28 @@ func (b *includeBuilder) path(basePath string) (string, error) {
 		return "", err
 	}
 
-	return addOptions(basePath, opts)
+	return b.base.addOptions(basePath, opts)
+}
+
+// includeKeys appends the keys of sideLoaders to the comma separated include string
+func includeKeys(include string, sideLoaders []sideload.SideLoader) string {
+	keys := make([]string, 0, len(sideLoaders)+1)
+	if include != "" {
+		keys = append(keys, include)
+	}
+	for _, s := range sideLoaders {
+		keys = append(keys, s.Key())
+	}
+	return strings.Join(keys, ",")
+}
+
+// unmarshalSideLoads runs each sideloader over the response body
+func unmarshalSideLoads(body []byte, sideLoaders []sideload.SideLoader) error {
+	for _, s := range sideLoaders {
+		err := s.Unmarshal(body)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
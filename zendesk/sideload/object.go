@@ -0,0 +1,31 @@
+package sideload
+
+// Users sideloads the users related to the result.
+// v should be a pointer to a slice of zendesk.User
+func Users(v interface{}) SideLoader {
+	return IncludeObject("users", v)
+}
+
+// Groups sideloads the groups related to the result.
+// v should be a pointer to a slice of zendesk.Group
+func Groups(v interface{}) SideLoader {
+	return IncludeObject("groups", v)
+}
+
+// Organizations sideloads the organizations related to the result.
+// v should be a pointer to a slice of zendesk.Organization
+func Organizations(v interface{}) SideLoader {
+	return IncludeObject("organizations", v)
+}
+
+// OrgUsers sideloads the users of the organizations in the result.
+// v should be a pointer to a slice of zendesk.User
+func OrgUsers(v interface{}) SideLoader {
+	return IncludeObject("users", v)
+}
+
+// Metrics sideloads the ticket metrics related to the result.
+// v should be a pointer to a slice of zendesk.TicketMetric
+func Metrics(v interface{}) SideLoader {
+	return IncludeObject("metric_sets", v)
+}
@@ -0,0 +1,24 @@
+package sideload
+
+import "time"
+
+// UserIdentity is an email address, phone number or other identity of a user
+// ref: https://developer.zendesk.com/rest_api/docs/support/user_identities
+type UserIdentity struct {
+	ID                 int64     `json:"id,omitempty"`
+	URL                string    `json:"url,omitempty"`
+	UserID             int64     `json:"user_id,omitempty"`
+	Type               string    `json:"type,omitempty"`
+	Value              string    `json:"value,omitempty"`
+	Verified           bool      `json:"verified,omitempty"`
+	Primary            bool      `json:"primary,omitempty"`
+	UndeliverableCount int64     `json:"undeliverable_count,omitempty"`
+	DeliverableState   string    `json:"deliverable_state,omitempty"`
+	CreatedAt          time.Time `json:"created_at,omitempty"`
+	UpdatedAt          time.Time `json:"updated_at,omitempty"`
+}
+
+// IncludeIdentities sideloads the identities of the users in the result
+func IncludeIdentities(identities *[]UserIdentity) SideLoader {
+	return IncludeObject("identities", identities)
+}
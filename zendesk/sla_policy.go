@@ -70,6 +70,7 @@ type SLAPolicyAPI interface {
 	GetSLAPolicy(ctx context.Context, id int64) (SLAPolicy, error)
 	UpdateSLAPolicy(ctx context.Context, id int64, slaPolicy SLAPolicy) (SLAPolicy, error)
 	DeleteSLAPolicy(ctx context.Context, id int64) error
+	MatchSLAPolicy(ctx context.Context, ticket Ticket) (*SLAPolicy, error)
 }
 
 // GetSLAPolicies fetch slaPolicy list
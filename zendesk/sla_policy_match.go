@@ -0,0 +1,60 @@
+package zendesk
+
+import (
+	"context"
+	"sort"
+)
+
+// MatchSLAPolicy fetches SLA policies and returns the first one by position whose
+// filter matches the ticket. It returns nil if no policy matches.
+// The filter is evaluated locally, so it tells which policy the ticket would
+// get with the current policies without updating the ticket
+func (z *Client) MatchSLAPolicy(ctx context.Context, ticket Ticket) (*SLAPolicy, error) {
+	var policies []SLAPolicy
+	opts := &SLAPolicyListOptions{PageOptions: PageOptions{PerPage: MaxPerPage}}
+	for {
+		list, page, err := z.GetSLAPolicies(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, list...)
+		next, ok := page.NextPageNumber()
+		if !ok {
+			break
+		}
+		opts.Page = next
+	}
+
+	return matchSLAPolicy(policies, ticket)
+}
+
+func matchSLAPolicy(policies []SLAPolicy, ticket Ticket) (*SLAPolicy, error) {
+	sorted := make([]SLAPolicy, len(policies))
+	copy(sorted, policies)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Position < sorted[j].Position })
+
+	for i := range sorted {
+		ok, err := sorted[i].Matches(ticket)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return &sorted[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// Matches reports whether the filter of the policy matches the ticket.
+// It's evaluated in the same way as EvaluateConditions, and returns
+// *UnsupportedConditionError for conditions which can't be evaluated from the ticket
+func (p SLAPolicy) Matches(ticket Ticket) (bool, error) {
+	var c Conditions
+	for _, f := range p.Filter.All {
+		c.All = append(c.All, Condition(f))
+	}
+	for _, f := range p.Filter.Any {
+		c.Any = append(c.Any, Condition(f))
+	}
+	return EvaluateConditions(c, ticket)
+}
@@ -0,0 +1,90 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func newSLAPolicy(id, position int64, all, any []SLAPolicyFilter) SLAPolicy {
+	p := SLAPolicy{ID: id, Position: position}
+	p.Filter.All = all
+	p.Filter.Any = any
+	return p
+}
+
+func TestMatchSLAPolicyByPriority(t *testing.T) {
+	policies := []SLAPolicy{
+		newSLAPolicy(3, 3, nil, nil),
+		newSLAPolicy(1, 1, []SLAPolicyFilter{
+			{Field: "priority", Operator: "is", Value: "urgent"},
+		}, nil),
+		newSLAPolicy(2, 2, []SLAPolicyFilter{
+			{Field: "priority", Operator: "greater_than_equal", Value: "high"},
+		}, []SLAPolicyFilter{
+			{Field: "group_id", Operator: "is", Value: "360004077472"},
+			{Field: "current_tags", Operator: "includes", Value: "vip enterprise"},
+		}),
+	}
+
+	ticket := Ticket{Priority: "high", Tags: []string{"enterprise"}}
+	policy, err := matchSLAPolicy(policies, ticket)
+	if err != nil {
+		t.Fatalf("Failed to match sla policy: %s", err)
+	}
+	if policy == nil || policy.ID != 2 {
+		t.Fatalf("expected policy 2 to match, but got %v", policy)
+	}
+
+	ticket.Priority = "low"
+	policy, err = matchSLAPolicy(policies, ticket)
+	if err != nil {
+		t.Fatalf("Failed to match sla policy: %s", err)
+	}
+	if policy == nil || policy.ID != 3 {
+		t.Fatalf("expected catch-all policy 3 to match, but got %v", policy)
+	}
+}
+
+func TestMatchSLAPolicyNoMatch(t *testing.T) {
+	policies := []SLAPolicy{
+		newSLAPolicy(1, 1, []SLAPolicyFilter{
+			{Field: "custom_fields_360005657120", Operator: "is", Value: "gold"},
+		}, nil),
+	}
+
+	ticket := Ticket{}
+	ticket.SetCustomField(360005657120, "silver")
+	policy, err := matchSLAPolicy(policies, ticket)
+	if err != nil {
+		t.Fatalf("Failed to match sla policy: %s", err)
+	}
+	if policy != nil {
+		t.Fatalf("expected no policy to match, but got %v", policy)
+	}
+}
+
+func TestMatchSLAPolicyUnsupportedField(t *testing.T) {
+	policies := []SLAPolicy{
+		newSLAPolicy(1, 1, []SLAPolicyFilter{
+			{Field: "via_id", Operator: "is", Value: "4"},
+		}, nil),
+	}
+
+	if _, err := matchSLAPolicy(policies, Ticket{}); err == nil {
+		t.Fatal("expected an error for an unsupported condition field")
+	}
+}
+
+func TestClientMatchSLAPolicy(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "sla_policies.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	policy, err := client.MatchSLAPolicy(ctx, Ticket{Type: "incident"})
+	if err != nil {
+		t.Fatalf("Failed to match sla policy: %s", err)
+	}
+	if policy == nil || policy.ID != 36000643450 {
+		t.Fatalf("expected policy 36000643450 to match, but got %v", policy)
+	}
+}
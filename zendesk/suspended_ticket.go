@@ -0,0 +1,148 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// SuspendedTicket is struct for suspended ticket payload
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/suspended_tickets
+type SuspendedTicket struct {
+	ID     int64  `json:"id,omitempty"`
+	URL    string `json:"url,omitempty"`
+	Author struct {
+		ID    int64  `json:"id"`
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	} `json:"author"`
+	Subject   string    `json:"subject,omitempty"`
+	Content   string    `json:"content,omitempty"`
+	Cause     string    `json:"cause,omitempty"`
+	CauseID   int64     `json:"cause_id,omitempty"`
+	MessageID string    `json:"message_id,omitempty"`
+	TicketID  int64     `json:"ticket_id,omitempty"`
+	BrandID   int64     `json:"brand_id,omitempty"`
+	Recipient string    `json:"recipient,omitempty"`
+	Via       *Via      `json:"via,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+}
+
+// SuspendedTicketAPI an interface containing suspended ticket related methods
+type SuspendedTicketAPI interface {
+	GetSuspendedTickets(ctx context.Context, opts *PageOptions) ([]SuspendedTicket, Page, error)
+	RecoverManySuspendedTickets(ctx context.Context, ids []int64) ([]SuspendedTicket, error)
+}
+
+// GetSuspendedTickets fetches suspended tickets
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/suspended_tickets#list-suspended-tickets
+func (z *Client) GetSuspendedTickets(ctx context.Context, opts *PageOptions) ([]SuspendedTicket, Page, error) {
+	var data struct {
+		SuspendedTickets []SuspendedTicket `json:"suspended_tickets"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	u, err := addOptions("/suspended_tickets.json", tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.SuspendedTickets, data.Page, nil
+}
+
+// SuspendedTicketRecoveryError is returned by RecoverManySuspendedTickets
+// when Zendesk fails to recover some of the suspended tickets
+type SuspendedTicketRecoveryError struct {
+	// Failed is the suspended tickets which couldn't be recovered
+	Failed []SuspendedTicket
+	Err    Error
+}
+
+func (e *SuspendedTicketRecoveryError) Error() string {
+	return fmt.Sprintf("failed to recover %d suspended tickets: %s", len(e.Failed), e.Err.Error())
+}
+
+// Unwrap returns the underlying Error
+func (e *SuspendedTicketRecoveryError) Unwrap() error {
+	return e.Err
+}
+
+// RecoverManySuspendedTickets recovers suspended tickets and returns the recovered ones.
+// Ids are sent in batches of 100. When some of the tickets fail to be recovered,
+// the others are still recovered, and a *SuspendedTicketRecoveryError is returned
+// with the suspended tickets which failed
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/suspended_tickets#recover-multiple-suspended-tickets
+func (z *Client) RecoverManySuspendedTickets(ctx context.Context, ids []int64) ([]SuspendedTicket, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no suspended tickets to recover")
+	}
+
+	var recovered []SuspendedTicket
+	var recoveryErr *SuspendedTicketRecoveryError
+	for _, chunk := range chunkIDs(ids, manyLimit) {
+		var req struct {
+			IDs string `url:"ids"`
+		}
+		req.IDs = joinIDs(chunk)
+
+		u, err := addOptions("/suspended_tickets/recover_many.json", req)
+		if err != nil {
+			return recovered, err
+		}
+
+		var result struct {
+			Tickets []SuspendedTicket `json:"tickets"`
+		}
+
+		body, err := z.send(ctx, http.MethodPut, u, nil, http.StatusOK)
+		if err != nil {
+			// Zendesk responds 422 with the tickets which failed to be recovered
+			var zerr Error
+			if !errors.As(err, &zerr) || zerr.Status() != http.StatusUnprocessableEntity {
+				return recovered, err
+			}
+			if err := json.Unmarshal(zerr.body, &result); err != nil {
+				return recovered, zerr
+			}
+
+			if recoveryErr == nil {
+				recoveryErr = &SuspendedTicketRecoveryError{}
+			}
+			recoveryErr.Failed = append(recoveryErr.Failed, result.Tickets...)
+			recoveryErr.Err = zerr
+			continue
+		}
+
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			return recovered, err
+		}
+		recovered = append(recovered, result.Tickets...)
+	}
+
+	if recoveryErr != nil {
+		return recovered, recoveryErr
+	}
+	return recovered, nil
+}
@@ -0,0 +1,98 @@
+package zendesk
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGetSuspendedTickets(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "suspended_tickets.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, _, err := client.GetSuspendedTickets(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get suspended tickets: %s", err)
+	}
+
+	if len(tickets) != 1 {
+		t.Fatalf("expected length of suspended tickets is 1, but got %d", len(tickets))
+	}
+	if tickets[0].Cause != "Detected as spam" || tickets[0].Author.Email != "styx@example.com" {
+		t.Fatalf("suspended ticket was not parsed as expected: %v", tickets[0])
+	}
+}
+
+func TestRecoverManySuspendedTickets(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodPut, "recover_many_suspended_tickets.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, err := client.RecoverManySuspendedTickets(ctx, []int64{3436, 3438})
+	if err != nil {
+		t.Fatalf("Failed to recover suspended tickets: %s", err)
+	}
+
+	if len(tickets) != 2 {
+		t.Fatalf("expected length of recovered tickets is 2, but got %d", len(tickets))
+	}
+	if tickets[0].ID != 3436 || tickets[0].TicketID != 67321 {
+		t.Fatalf("recovered ticket was not parsed as expected: %v", tickets[0])
+	}
+}
+
+func TestRecoverManySuspendedTicketsPartialFailure(t *testing.T) {
+	var requests []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/suspended_tickets/recover_many.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		requests = append(requests, r.URL.Query().Get("ids"))
+		if len(requests) == 1 {
+			w.Write(readFixture(filepath.Join(http.MethodPut, "recover_many_suspended_tickets.json")))
+			return
+		}
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write(readFixture(filepath.Join(http.MethodPut, "recover_many_suspended_tickets_failed.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ids := make([]int64, 150)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	tickets, err := client.RecoverManySuspendedTickets(ctx, ids)
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 batches, but got %d requests", len(requests))
+	}
+	if !strings.HasPrefix(requests[1], "101,") {
+		t.Fatalf("second batch has unexpected ids %s", requests[1])
+	}
+	if len(tickets) != 2 {
+		t.Fatalf("expected tickets of the first batch to be recovered, but got %d", len(tickets))
+	}
+
+	var recoveryErr *SuspendedTicketRecoveryError
+	if !errors.As(err, &recoveryErr) {
+		t.Fatalf("expected SuspendedTicketRecoveryError, but got %v", err)
+	}
+	if len(recoveryErr.Failed) != 1 || recoveryErr.Failed[0].ID != 3437 {
+		t.Fatalf("expected suspended ticket 3437 to fail, but got %v", recoveryErr.Failed)
+	}
+	if recoveryErr.Err.Status() != http.StatusUnprocessableEntity {
+		t.Fatalf("unexpected status %d", recoveryErr.Err.Status())
+	}
+}
+
+func TestRecoverManySuspendedTicketsEmpty(t *testing.T) {
+	client, _ := NewClient(nil)
+	if _, err := client.RecoverManySuspendedTickets(ctx, nil); err == nil {
+		t.Fatal("expected an error for no suspended tickets")
+	}
+}
@@ -0,0 +1,121 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// TalkQueueActivity is struct for current queue activity of Talk
+//
+// ref: https://developer.zendesk.com/rest_api/docs/voice-api/stats#show-current-queue-activity
+type TalkQueueActivity struct {
+	AgentsOnline      int64 `json:"agents_online"`
+	CallsWaiting      int64 `json:"calls_waiting"`
+	CallbacksWaiting  int64 `json:"callbacks_waiting"`
+	AverageWaitTime   int64 `json:"average_wait_time"`
+	LongestWaitTime   int64 `json:"longest_wait_time"`
+	EmbeddableWaiting int64 `json:"embeddable_callbacks_waiting"`
+}
+
+// TalkAgentActivity is struct for activity of a Talk agent
+//
+// ref: https://developer.zendesk.com/rest_api/docs/voice-api/stats#list-agents-activity
+type TalkAgentActivity struct {
+	AgentID           int64  `json:"agent_id"`
+	Name              string `json:"name"`
+	AvatarURL         string `json:"avatar_url"`
+	AgentState        string `json:"agent_state"`
+	CallStatus        string `json:"call_status"`
+	Via               string `json:"via"`
+	ForwardingNumber  string `json:"forwarding_number"`
+	AvailableTime     int64  `json:"available_time"`
+	AwayTime          int64  `json:"away_time"`
+	OnlineTime        int64  `json:"online_time"`
+	CallsAccepted     int64  `json:"calls_accepted"`
+	CallsDenied       int64  `json:"calls_denied"`
+	CallsMissed       int64  `json:"calls_missed"`
+	AverageTalkTime   int64  `json:"average_talk_time"`
+	AverageWrapUpTime int64  `json:"average_wrap_up_time"`
+	TotalCallDuration int64  `json:"total_call_duration"`
+	TotalTalkTime     int64  `json:"total_talk_time"`
+	TotalWrapUpTime   int64  `json:"total_wrap_up_time"`
+}
+
+// TalkAvailability is struct for availability of a Talk agent.
+// AgentState can take "online", "offline" or "away"
+//
+// ref: https://developer.zendesk.com/rest_api/docs/voice-api/availabilities#json-format
+type TalkAvailability struct {
+	AgentState string `json:"agent_state"`
+	Via        string `json:"via"`
+	AvatarURL  string `json:"avatar_url"`
+	Name       string `json:"name"`
+}
+
+// TalkAPI an interface containing all Talk related methods
+type TalkAPI interface {
+	GetTalkStats(ctx context.Context) (TalkQueueActivity, error)
+	GetAgentsActivity(ctx context.Context) ([]TalkAgentActivity, error)
+	GetTalkAvailability(ctx context.Context, agentID int64) (TalkAvailability, error)
+}
+
+// GetTalkStats fetches current queue activity of Talk
+//
+// ref: https://developer.zendesk.com/rest_api/docs/voice-api/stats#show-current-queue-activity
+func (z *Client) GetTalkStats(ctx context.Context) (TalkQueueActivity, error) {
+	var result struct {
+		CurrentQueueActivity TalkQueueActivity `json:"current_queue_activity"`
+	}
+
+	body, err := z.get(ctx, talkAPI.path("/stats/current_queue_activity.json"))
+	if err != nil {
+		return TalkQueueActivity{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkQueueActivity{}, err
+	}
+	return result.CurrentQueueActivity, nil
+}
+
+// GetAgentsActivity fetches activity of Talk agents
+//
+// ref: https://developer.zendesk.com/rest_api/docs/voice-api/stats#list-agents-activity
+func (z *Client) GetAgentsActivity(ctx context.Context) ([]TalkAgentActivity, error) {
+	var result struct {
+		AgentsActivity []TalkAgentActivity `json:"agents_activity"`
+	}
+
+	body, err := z.get(ctx, talkAPI.path("/stats/agents_activity.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.AgentsActivity, nil
+}
+
+// GetTalkAvailability fetches availability of the Talk agent
+//
+// ref: https://developer.zendesk.com/rest_api/docs/voice-api/availabilities#show-availability
+func (z *Client) GetTalkAvailability(ctx context.Context, agentID int64) (TalkAvailability, error) {
+	var result struct {
+		Availability TalkAvailability `json:"availability"`
+	}
+
+	body, err := z.get(ctx, talkAPI.path(fmt.Sprintf("/availabilities/%d.json", agentID)))
+	if err != nil {
+		return TalkAvailability{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TalkAvailability{}, err
+	}
+	return result.Availability, nil
+}
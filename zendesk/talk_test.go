@@ -0,0 +1,54 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetTalkStats(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "talk_current_queue_activity.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	stats, err := client.GetTalkStats(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get talk stats: %s", err)
+	}
+
+	if stats.CallsWaiting != 3 || stats.AgentsOnline != 5 || stats.AverageWaitTime != 120 {
+		t.Fatalf("Talk stats were not parsed as expected: %v", stats)
+	}
+}
+
+func TestGetAgentsActivity(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "talk_agents_activity.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	activities, err := client.GetAgentsActivity(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get agents activity: %s", err)
+	}
+
+	if len(activities) != 1 {
+		t.Fatalf("expected length of agents activity is 1, but got %d", len(activities))
+	}
+	if activities[0].AgentState != "online" || activities[0].CallsAccepted != 12 {
+		t.Fatalf("Agent activity was not parsed as expected: %v", activities[0])
+	}
+}
+
+func TestGetTalkAvailability(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "talk_availability.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	availability, err := client.GetTalkAvailability(ctx, 1234)
+	if err != nil {
+		t.Fatalf("Failed to get talk availability: %s", err)
+	}
+
+	if availability.AgentState != "away" {
+		t.Fatalf(`agent_state expect "away", but got "%s"`, availability.AgentState)
+	}
+}
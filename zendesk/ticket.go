@@ -1,11 +1,15 @@
 package zendesk
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strconv"
-	"strings"
+	"sync"
 	"time"
 
 	"github.com/tylerconlee/zendesk-go/zendesk/sideload"
@@ -13,31 +17,62 @@ import (
 
 type CustomField struct {
 	ID int64 `json:"id"`
-	// Valid types are string or []string.
+	// Valid types are string, []string, bool, int64, float64 or nil.
 	Value interface{} `json:"value"`
 }
 
 // Custom Unmarshal function required because a custom field's value can be
-// a string or array of strings.
+// a string, array of strings, bool, integer, decimal or null.
+// An integer is decoded as int64, and a decimal as float64.
+// The id may be encoded as either a number or a string
 func (cf *CustomField) UnmarshalJSON(data []byte) error {
 	var temp map[string]interface{}
-	if err := json.Unmarshal(data, &temp); err != nil {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&temp); err != nil {
 		return err
 	}
 
-	cf.ID = int64(temp["id"].(float64))
+	switch id := temp["id"].(type) {
+	case json.Number:
+		v, err := id.Int64()
+		if err != nil {
+			return fmt.Errorf("custom field id %s is not an integer", id)
+		}
+		cf.ID = v
+	case string:
+		v, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return fmt.Errorf("custom field id %q is not an integer", id)
+		}
+		cf.ID = v
+	case nil:
+		return fmt.Errorf("custom field id is missing in %s", string(data))
+	default:
+		return fmt.Errorf("%T is an invalid type for custom field id", id)
+	}
 
 	switch v := temp["value"].(type) {
 	case string, nil, bool:
 		cf.Value = v
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			cf.Value = i
+			break
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return fmt.Errorf("%s is an invalid number for custom field value", v)
+		}
+		cf.Value = f
 	case []interface{}:
-		var list []string
+		list := make([]string, 0, len(v))
 
-		for _, v := range temp["value"].([]interface{}) {
-			if s, ok := v.(string); ok {
+		for _, item := range v {
+			if s, ok := item.(string); ok {
 				list = append(list, s)
 			} else {
-				return fmt.Errorf("%T is an invalid type for custom field value", v)
+				return fmt.Errorf("%T is an invalid type for custom field value", item)
 			}
 		}
 
@@ -49,6 +84,32 @@ func (cf *CustomField) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON keeps the value type of the custom field.
+// An empty []string is sent as [], and a nil value is sent as null to clear the field
+func (cf CustomField) MarshalJSON() ([]byte, error) {
+	var value interface{}
+
+	switch v := cf.Value.(type) {
+	case nil, string, bool, int, int32, int64, float64:
+		value = v
+	case []string:
+		if v == nil {
+			v = []string{}
+		}
+		value = v
+	default:
+		return nil, fmt.Errorf("%T is an invalid type for custom field value", v)
+	}
+
+	return json.Marshal(struct {
+		ID    int64       `json:"id"`
+		Value interface{} `json:"value"`
+	}{
+		ID:    cf.ID,
+		Value: value,
+	})
+}
+
 type Ticket struct {
 	ID              int64         `json:"id,omitempty"`
 	URL             string        `json:"url,omitempty"`
@@ -59,6 +120,7 @@ type Ticket struct {
 	Description     string        `json:"description,omitempty"`
 	Priority        string        `json:"priority,omitempty"`
 	Status          string        `json:"status,omitempty"`
+	CustomStatusID  int64         `json:"custom_status_id,omitempty"`
 	Recipient       string        `json:"recipient,omitempty"`
 	RequesterID     int64         `json:"requester_id,omitempty"`
 	SubmitterID     int64         `json:"submitter_id,omitempty"`
@@ -75,7 +137,8 @@ type Ticket struct {
 	Tags            []string      `json:"tags,omitempty"`
 	CustomFields    []CustomField `json:"custom_fields,omitempty"`
 
-	// TODO: Via          #123
+	// Via tells the channel which created the ticket
+	Via *Via `json:"via,omitempty"`
 
 	SatisfactionRating struct {
 		ID      int64  `json:"id"`
@@ -98,6 +161,14 @@ type Ticket struct {
 	// Collaborators is POST only
 	Collaborators Collaborators `json:"collaborators,omitempty"`
 
+	// Requester is POST only. It creates the requester as a new user
+	// unless a user with the email already exists
+	Requester *Requester `json:"requester,omitempty"`
+
+	// EmailCCs and Followers are write only mutations
+	EmailCCs  []EmailCC  `json:"email_ccs,omitempty"`
+	Followers []Follower `json:"followers,omitempty"`
+
 	// Comment is POST only and required
 	Comment TicketComment `json:"comment,omitempty"`
 	Slas    struct {
@@ -128,7 +199,7 @@ type Ticket struct {
 			ID         int64     `json:"id,omitempty"`
 			TicketID   int       `json:"ticket_id,omitempty"`
 			Metric     string    `json:"metric,omitempty"`
-			InstanceID int       `json:"instance_id",omitempty`
+			InstanceID int       `json:"instance_id,omitempty"`
 			Type       string    `json:"type,omitempty"`
 			Time       time.Time `json:"time,omitempty"`
 		} `json:"resolution_time,omitempty"`
@@ -179,6 +250,76 @@ type Ticket struct {
 	// TODO: TicketAudit (POST only) #126
 }
 
+// ticketReadOnlyFields are computed by Zendesk and rejected or ignored when sent
+var ticketReadOnlyFields = []string{
+	"url",
+	"description",
+	"has_incidents",
+	"satisfaction_rating",
+	"sharing_agreement_ids",
+	"followup_ids",
+	"allow_channelback",
+	"allow_attachments",
+	"is_public",
+	"created_at",
+	"updated_at",
+	"slas",
+	"metric_events",
+	"via",
+}
+
+// ticketPayload is Ticket in request bodies. It drops read-only fields and
+// unset values so that a fetched ticket can be modified and sent back by
+// CreateTicket or bulk operations, while Ticket itself marshals every field
+type ticketPayload Ticket
+
+// ticketPayloads converts tickets for a request body
+func ticketPayloads(tickets []Ticket) []ticketPayload {
+	payloads := make([]ticketPayload, len(tickets))
+	for i, t := range tickets {
+		payloads[i] = ticketPayload(t)
+	}
+	return payloads
+}
+
+// MarshalJSON drops read-only fields and unset values of the ticket
+func (t ticketPayload) MarshalJSON() ([]byte, error) {
+	tmp := Ticket(t)
+	b, err := json.Marshal(&tmp)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+
+	for _, key := range ticketReadOnlyFields {
+		delete(fields, key)
+	}
+	if t.DueAt.IsZero() {
+		delete(fields, "due_at")
+	}
+	if len(t.Collaborators.List()) == 0 {
+		delete(fields, "collaborators")
+	}
+	if t.Comment.Body == "" && t.Comment.HTMLBody == "" && len(t.Comment.Attachments) == 0 && len(t.Comment.Uploads) == 0 {
+		delete(fields, "comment")
+	}
+
+	return json.Marshal(fields)
+}
+
+// ErrIncrementalSort is returned by GetIncrementalTickets and GetIncrementalTicketsCursor
+// when SortBy or SortOrder is set,
+// because incremental exports are always ordered by update time
+var ErrIncrementalSort = errors.New("sort_by and sort_order are not supported by incremental exports")
+
+// TicketListOptions is options for GetTickets and the incremental ticket exports.
+// GetIncrementalTickets and GetIncrementalTicketsCursor accept only StartTime,
+// Cursor, PerPage and Sideload,
+// and returns ErrIncrementalSort if SortBy or SortOrder is set
 type TicketListOptions struct {
 	PageOptions
 
@@ -198,20 +339,136 @@ type TicketListOptions struct {
 
 	// Sideload includes additional endpoints
 	Sideload string `url:"include,omitempty"`
+
+	// MaxResults caps the number of tickets collected by GetAllTickets.
+	// Zero means no limit. It is not sent to the API
+	MaxResults int `url:"-"`
 }
 
+// showManyConcurrency is the max number of concurrent show_many requests
+const showManyConcurrency = 4
+
 // TicketAPI an interface containing all ticket related methods
 type TicketAPI interface {
-	GetTickets(ctx context.Context, opts *TicketListOptions) ([]Ticket, Page, error)
+	GetTickets(ctx context.Context, opts *TicketListOptions, sideLoad ...sideload.SideLoader) ([]Ticket, Page, error)
+	GetAllTickets(ctx context.Context, opts *TicketListOptions) ([]Ticket, error)
+	GetTicketsSince(ctx context.Context, since time.Time) (<-chan Ticket, <-chan error)
+	GetTicketsRequestedByUser(ctx context.Context, userID int64, opts *TicketListOptions) ([]Ticket, Page, error)
+	GetTicketsAssignedToUser(ctx context.Context, userID int64, opts *TicketListOptions) ([]Ticket, Page, error)
+	GetTicketsCCdUser(ctx context.Context, userID int64, opts *TicketListOptions) ([]Ticket, Page, error)
+	GetTicketsForOrganization(ctx context.Context, orgID int64, opts *TicketListOptions) ([]Ticket, Page, error)
+	GetTicketsByExternalID(ctx context.Context, externalID string, opts *PageOptions) ([]Ticket, Page, error)
+	GetTicketCountForUser(ctx context.Context, userID int64) (int64, error)
+	GetTicketCountForGroup(ctx context.Context, groupID int64) (int64, error)
 	GetTicket(ctx context.Context, id int64, sideload ...sideload.SideLoader) (Ticket, error)
-	GetMultipleTickets(ctx context.Context, ticketIDs []int64) ([]Ticket, error)
-	CreateTicket(ctx context.Context, ticket Ticket) (Ticket, error)
+	GetTicketWithMetrics(ctx context.Context, ticketID int64) (Ticket, TicketMetric, error)
+	GetMultipleTickets(ctx context.Context, ticketIDs []int64, sideLoad ...sideload.SideLoader) ([]Ticket, error)
+	GetTicketsConcurrent(ctx context.Context, ids []int64, concurrency int) (map[int64]Ticket, map[int64]error)
+	CreateTicket(ctx context.Context, ticket Ticket, opts ...RequestOption) (Ticket, error)
+	CreateFollowupTicket(ctx context.Context, sourceTicketID int64, ticket Ticket) (Ticket, error)
+	CreateManyTickets(ctx context.Context, tickets []Ticket) ([]JobStatus, error)
+	UpdateManyTickets(ctx context.Context, tickets []Ticket) ([]JobStatus, error)
+	DeleteManyTickets(ctx context.Context, ticketIDs []int64) ([]JobStatus, error)
+	AddTagsToManyTickets(ctx context.Context, ticketIDs []int64, tags []string) ([]JobStatus, error)
+	RemoveTagsFromManyTickets(ctx context.Context, ticketIDs []int64, tags []string) ([]JobStatus, error)
+	UpdateTicket(ctx context.Context, ticketID int64, update TicketUpdate) (Ticket, error)
+	UpdateTicketSafe(ctx context.Context, ticketID int64, update TicketUpdate, updatedStamp time.Time) (Ticket, error)
+	AddTicketEmailCC(ctx context.Context, ticketID int64, ccs ...EmailCC) error
+	RemoveTicketEmailCC(ctx context.Context, ticketID int64, ccs ...EmailCC) error
 }
 
 // GetTickets get ticket list
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/tickets#list-tickets
-func (z *Client) GetTickets(ctx context.Context, opts *TicketListOptions) ([]Ticket, Page, error) {
+func (z *Client) GetTickets(ctx context.Context, opts *TicketListOptions, sideLoad ...sideload.SideLoader) ([]Ticket, Page, error) {
+	return z.getTickets(ctx, "/tickets.json", opts, sideLoad...)
+}
+
+// GetTicketsRequestedByUser get tickets requested by the user
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#list-tickets
+func (z *Client) GetTicketsRequestedByUser(ctx context.Context, userID int64, opts *TicketListOptions) ([]Ticket, Page, error) {
+	return z.getTickets(ctx, fmt.Sprintf("/users/%d/tickets/requested.json", userID), opts)
+}
+
+// GetTicketsAssignedToUser get tickets assigned to the user
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#list-tickets
+func (z *Client) GetTicketsAssignedToUser(ctx context.Context, userID int64, opts *TicketListOptions) ([]Ticket, Page, error) {
+	return z.getTickets(ctx, fmt.Sprintf("/users/%d/tickets/assigned.json", userID), opts)
+}
+
+// GetTicketsCCdUser get tickets the user is CC'd on
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#list-tickets
+func (z *Client) GetTicketsCCdUser(ctx context.Context, userID int64, opts *TicketListOptions) ([]Ticket, Page, error) {
+	return z.getTickets(ctx, fmt.Sprintf("/users/%d/tickets/ccd.json", userID), opts)
+}
+
+// GetTicketsForOrganization get tickets of the organization
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#list-tickets
+func (z *Client) GetTicketsForOrganization(ctx context.Context, orgID int64, opts *TicketListOptions) ([]Ticket, Page, error) {
+	return z.getTickets(ctx, fmt.Sprintf("/organizations/%d/tickets.json", orgID), opts)
+}
+
+// GetTicketCountForUser returns the number of unsolved tickets assigned to the user.
+// The count comes from search, so it may lag behind recent changes a little
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/search#show-results-count
+func (z *Client) GetTicketCountForUser(ctx context.Context, userID int64) (int64, error) {
+	return z.SearchCount(ctx, fmt.Sprintf("type:ticket status<solved assignee:%d", userID))
+}
+
+// GetTicketCountForGroup returns the number of unsolved tickets in the group.
+// The count comes from search, so it may lag behind recent changes a little
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/search#show-results-count
+func (z *Client) GetTicketCountForGroup(ctx context.Context, groupID int64) (int64, error) {
+	return z.SearchCount(ctx, fmt.Sprintf("type:ticket status<solved group:%d", groupID))
+}
+
+// GetTicketsByExternalID get tickets by external id.
+// It may return multiple tickets since external ids are not guaranteed to be unique
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#list-tickets-by-external-id
+func (z *Client) GetTicketsByExternalID(ctx context.Context, externalID string, opts *PageOptions) ([]Ticket, Page, error) {
+	var data struct {
+		Tickets []Ticket `json:"tickets"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	req := struct {
+		PageOptions
+		ExternalID string `url:"external_id"`
+	}{
+		PageOptions: *tmp,
+		ExternalID:  externalID,
+	}
+
+	u, err := addOptions("/tickets.json", req)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Tickets, data.Page, nil
+}
+
+func (z *Client) getTickets(ctx context.Context, path string, opts *TicketListOptions, sideLoad ...sideload.SideLoader) ([]Ticket, Page, error) {
 	var data struct {
 		Tickets []Ticket `json:"tickets"`
 		Page
@@ -222,7 +479,10 @@ func (z *Client) GetTickets(ctx context.Context, opts *TicketListOptions) ([]Tic
 		tmp = &TicketListOptions{}
 	}
 
-	u, err := addOptions("/tickets.json", tmp)
+	o := *tmp
+	o.Sideload = includeKeys(o.Sideload, sideLoad)
+
+	u, err := addOptions(path, o)
 	if err != nil {
 		return nil, Page{}, err
 	}
@@ -236,13 +496,191 @@ func (z *Client) GetTickets(ctx context.Context, opts *TicketListOptions) ([]Tic
 	if err != nil {
 		return nil, Page{}, err
 	}
+
+	err = unmarshalSideLoads(body, sideLoad)
+	if err != nil {
+		return nil, Page{}, err
+	}
 	return data.Tickets, data.Page, nil
 }
 
-// GetTickets get ticket list
+// GetAllTickets collects tickets of every page into a single list.
+// When StartTime or Cursor is set, it follows the cursor of incremental export
+// until the end of stream. Otherwise it follows the offset pages of GetTickets.
+// MaxResults in opts caps the number of collected tickets
+func (z *Client) GetAllTickets(ctx context.Context, opts *TicketListOptions) ([]Ticket, error) {
+	tmp := TicketListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+
+	if tmp.StartTime != "" || tmp.Cursor != "" {
+		return z.getAllIncrementalTickets(ctx, tmp)
+	}
+
+	if tmp.Page == 0 {
+		tmp.Page = 1
+	}
+
+	var all []Ticket
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		tickets, page, err := z.GetTickets(ctx, &tmp)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, tickets...)
+		if tmp.MaxResults > 0 && len(all) >= tmp.MaxResults {
+			return all[:tmp.MaxResults], nil
+		}
+
+		if !page.HasNext() || len(tickets) == 0 {
+			return all, nil
+		}
+		tmp.Page++
+	}
+}
+
+// getAllIncrementalTickets follows the cursor of incremental ticket export
+func (z *Client) getAllIncrementalTickets(ctx context.Context, opts TicketListOptions) ([]Ticket, error) {
+	var all []Ticket
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		tickets, afterURL, eos, err := z.GetIncrementalTicketsCursor(ctx, &opts)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, tickets...)
+		if opts.MaxResults > 0 && len(all) >= opts.MaxResults {
+			return all[:opts.MaxResults], nil
+		}
+
+		if eos {
+			return all, nil
+		}
+
+		cursor, ok, err := nextIncrementalCursor(afterURL, opts.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return all, nil
+		}
+		opts.Cursor = cursor
+		opts.StartTime = ""
+	}
+}
+
+// nextIncrementalCursor extracts the cursor of the next page from after_url.
+// It returns false if there is no cursor or it's the current one, which means no progress
+func nextIncrementalCursor(afterURL string, current string) (string, bool, error) {
+	if afterURL == "" {
+		return "", false, nil
+	}
+
+	u, err := url.Parse(afterURL)
+	if err != nil {
+		return "", false, err
+	}
+
+	cursor := u.Query().Get("cursor")
+	if cursor == "" || cursor == current {
+		return "", false, nil
+	}
+	return cursor, true, nil
+}
+
+// GetTicketsSince streams every ticket changed since the time using the cursor based
+// incremental export. It follows the cursor until the end of stream. The cursor doesn't
+// repeat tickets across pages, but a ticket updated again during the export is sent
+// again with its new state. Both channels are closed when the export ends,
+// and at most one error is sent, after which no more tickets are sent.
+// Cancel ctx to stop the export early
 //
-// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#list-tickets
-func (z *Client) GetIncrementalTickets(ctx context.Context, opts *TicketListOptions) ([]Ticket, string, bool, error) {
+// ref: https://developer.zendesk.com/rest_api/docs/support/incremental_export#incremental-ticket-export-cursor-based
+func (z *Client) GetTicketsSince(ctx context.Context, since time.Time) (<-chan Ticket, <-chan error) {
+	tickets := make(chan Ticket)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(tickets)
+		defer close(errs)
+
+		opts := TicketListOptions{StartTime: strconv.FormatInt(since.Unix(), 10)}
+		for {
+			page, afterURL, eos, err := z.GetIncrementalTicketsCursor(ctx, &opts)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, ticket := range page {
+				select {
+				case tickets <- ticket:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			if eos {
+				return
+			}
+
+			cursor, ok, err := nextIncrementalCursor(afterURL, opts.Cursor)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if !ok {
+				return
+			}
+			opts.Cursor = cursor
+			opts.StartTime = ""
+		}
+	}()
+
+	return tickets, errs
+}
+
+// incrementalTicketListOptions is TicketListOptions for incremental export,
+// which accepts larger pages than list endpoints
+type incrementalTicketListOptions struct {
+	TicketListOptions
+}
+
+// Validate checks the options with the page limit of incremental export
+func (o incrementalTicketListOptions) Validate() error {
+	return o.PageOptions.validate(incrementalExportLimit)
+}
+
+// GetIncrementalTickets get ticket list with the time based incremental export
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/incremental_export#incremental-ticket-export
+func (z *Client) GetIncrementalTickets(ctx context.Context, opts *TicketListOptions, sideLoad ...sideload.SideLoader) ([]Ticket, string, bool, error) {
+	return z.getIncrementalTickets(ctx, "/incremental/tickets.json", opts, sideLoad)
+}
+
+// GetIncrementalTicketsCursor get ticket list with the cursor based incremental export.
+// Set StartTime of opts for the first page and Cursor for the following ones.
+// It returns after_url of the page, which has the cursor of the next page, and
+// whether the export reached the end of stream
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/incremental_export#incremental-ticket-export-cursor-based
+func (z *Client) GetIncrementalTicketsCursor(ctx context.Context, opts *TicketListOptions, sideLoad ...sideload.SideLoader) ([]Ticket, string, bool, error) {
+	return z.getIncrementalTickets(ctx, "/incremental/tickets/cursor.json", opts, sideLoad)
+}
+
+// getIncrementalTickets gets a page of the incremental ticket export at the path
+func (z *Client) getIncrementalTickets(ctx context.Context, path string, opts *TicketListOptions, sideLoad []sideload.SideLoader) ([]Ticket, string, bool, error) {
 	var data struct {
 		Tickets []Ticket `json:"tickets"`
 		URL     string   `json:"after_url"`
@@ -253,12 +691,28 @@ func (z *Client) GetIncrementalTickets(ctx context.Context, opts *TicketListOpti
 	if tmp == nil {
 		tmp = &TicketListOptions{}
 	}
+	if tmp.SortBy != "" || tmp.SortOrder != "" {
+		return nil, "", true, ErrIncrementalSort
+	}
+
+	o := incrementalTicketListOptions{*tmp}
+	o.Sideload = includeKeys(o.Sideload, sideLoad)
 
-	u, err := addOptions("/incremental/tickets.json", tmp)
+	u, err := addOptions(path, o)
 	if err != nil {
 		return nil, "", true, err
 	}
 
+	// Export pages can be large, so decode them while streaming
+	// unless sideloaders need the whole body
+	if len(sideLoad) == 0 {
+		err = z.getJSON(ctx, u, &data)
+		if err != nil {
+			return nil, "", true, err
+		}
+		return data.Tickets, data.URL, data.EoS, nil
+	}
+
 	body, err := z.get(ctx, u)
 	if err != nil {
 		return nil, "", true, err
@@ -268,6 +722,11 @@ func (z *Client) GetIncrementalTickets(ctx context.Context, opts *TicketListOpti
 	if err != nil {
 		return nil, "", true, err
 	}
+
+	err = unmarshalSideLoads(body, sideLoad)
+	if err != nil {
+		return nil, "", true, err
+	}
 	return data.Tickets, data.URL, data.EoS, nil
 }
 
@@ -310,22 +769,80 @@ func (z *Client) GetTicket(ctx context.Context, ticketID int64, sideLoad ...side
 	return result.Ticket, nil
 }
 
-// GetMultipleTickets gets multiple specified tickets
+// GetMultipleTickets gets multiple specified tickets.
+// Zendesk accepts at most 100 ids per request, so ticketIDs are split into
+// chunks which are fetched concurrently. The order of results follows the chunks,
+// and the first error cancels the remaining requests.
+// A sideloader fills its target from a single response, so sideloading is
+// supported for up to 100 ids
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/tickets#show-multiple-tickets
-func (z *Client) GetMultipleTickets(ctx context.Context, ticketIDs []int64) ([]Ticket, error) {
+func (z *Client) GetMultipleTickets(ctx context.Context, ticketIDs []int64, sideLoad ...sideload.SideLoader) ([]Ticket, error) {
+	if len(ticketIDs) == 0 {
+		return nil, fmt.Errorf("no tickets to get")
+	}
+	if len(ticketIDs) <= manyLimit {
+		return z.getMultipleTickets(ctx, ticketIDs, sideLoad...)
+	}
+	if len(sideLoad) > 0 {
+		return nil, fmt.Errorf("sideloading supports at most %d tickets, but got %d", manyLimit, len(ticketIDs))
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	chunks := chunkIDs(ticketIDs, manyLimit)
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		results  = make([][]Ticket, len(chunks))
+		sem      = make(chan struct{}, showManyConcurrency)
+	)
+
+	for i, chunk := range chunks {
+		wg.Add(1)
+		go func(i int, chunk []int64) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			tickets, err := z.getMultipleTickets(ctx, chunk)
+			if err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			results[i] = tickets
+		}(i, chunk)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	all := make([]Ticket, 0, len(ticketIDs))
+	for _, tickets := range results {
+		all = append(all, tickets...)
+	}
+	return all, nil
+}
+
+func (z *Client) getMultipleTickets(ctx context.Context, ticketIDs []int64, sideLoad ...sideload.SideLoader) ([]Ticket, error) {
 	var result struct {
 		Tickets []Ticket `json:"tickets"`
 	}
 
 	var req struct {
-		IDs string `url:"ids,omitempty"`
+		IDs      string `url:"ids,omitempty"`
+		Sideload string `url:"include,omitempty"`
 	}
-	idStrs := make([]string, len(ticketIDs))
-	for i := 0; i < len(ticketIDs); i++ {
-		idStrs[i] = strconv.FormatInt(ticketIDs[i], 10)
-	}
-	req.IDs = strings.Join(idStrs, ",")
+	req.IDs = joinIDs(ticketIDs)
+	req.Sideload = includeKeys("", sideLoad)
 
 	u, err := addOptions("/tickets/show_many.json", req)
 	if err != nil {
@@ -341,19 +858,243 @@ func (z *Client) GetMultipleTickets(ctx context.Context, ticketIDs []int64) ([]T
 	if err != nil {
 		return nil, err
 	}
+
+	err = unmarshalSideLoads(body, sideLoad)
+	if err != nil {
+		return nil, err
+	}
 	return result.Tickets, nil
 }
 
-// CreateTicket create a new ticket
+// CreateTicket create a new ticket.
+// Pass WithIdempotencyKey to make retries of the create safe
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/tickets#create-ticket
-func (z *Client) CreateTicket(ctx context.Context, ticket Ticket) (Ticket, error) {
-	var data, result struct {
+func (z *Client) CreateTicket(ctx context.Context, ticket Ticket, opts ...RequestOption) (Ticket, error) {
+	var data struct {
+		Ticket ticketPayload `json:"ticket"`
+	}
+	data.Ticket = ticketPayload(ticket)
+
+	var result struct {
+		Ticket Ticket `json:"ticket"`
+	}
+
+	body, err := z.post(ctx, "/tickets.json", data, opts...)
+	if err != nil {
+		return Ticket{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return Ticket{}, err
+	}
+	return result.Ticket, nil
+}
+
+// CreateFollowupTicket creates a follow-up of the closed ticket.
+// Closed tickets can't be reopened, so they are continued by a new ticket
+// linked with via_followup_source_id
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#creating-follow-up-tickets
+func (z *Client) CreateFollowupTicket(ctx context.Context, sourceTicketID int64, ticket Ticket) (Ticket, error) {
+	ticket.ViaFollowupSourceID = sourceTicketID
+	return z.CreateTicket(ctx, ticket)
+}
+
+// CreateManyTickets creates tickets in background jobs.
+// Each ticket requires a comment. Tickets are sent in batches of 100,
+// and a job status is returned for each batch
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#create-many-tickets
+func (z *Client) CreateManyTickets(ctx context.Context, tickets []Ticket) ([]JobStatus, error) {
+	if len(tickets) == 0 {
+		return nil, fmt.Errorf("no tickets to create")
+	}
+	for i, t := range tickets {
+		if t.Comment.Body == "" && t.Comment.HTMLBody == "" {
+			return nil, fmt.Errorf("tickets[%d] does not have a comment", i)
+		}
+	}
+
+	return z.sendManyTickets(ctx, http.MethodPost, "/tickets/create_many.json", tickets)
+}
+
+// UpdateManyTickets updates tickets in background jobs. Each ticket must have its ID.
+// Tickets are sent in batches of 100, and a job status is returned for each batch
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#update-many-tickets
+func (z *Client) UpdateManyTickets(ctx context.Context, tickets []Ticket) ([]JobStatus, error) {
+	if len(tickets) == 0 {
+		return nil, fmt.Errorf("no tickets to update")
+	}
+
+	return z.sendManyTickets(ctx, http.MethodPut, "/tickets/update_many.json", tickets)
+}
+
+// DeleteManyTickets deletes tickets in background jobs.
+// Ids are sent in batches of 100, and a job status is returned for each batch
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#bulk-delete-tickets
+func (z *Client) DeleteManyTickets(ctx context.Context, ticketIDs []int64) ([]JobStatus, error) {
+	if len(ticketIDs) == 0 {
+		return nil, fmt.Errorf("no tickets to delete")
+	}
+
+	var jobs []JobStatus
+	for _, ids := range chunkIDs(ticketIDs, manyLimit) {
+		var req struct {
+			IDs string `url:"ids"`
+		}
+		req.IDs = joinIDs(ids)
+
+		u, err := addOptions("/tickets/destroy_many.json", req)
+		if err != nil {
+			return jobs, err
+		}
+
+		body, err := z.send(ctx, http.MethodDelete, u, nil, http.StatusOK)
+		if err != nil {
+			return jobs, err
+		}
+
+		job, err := unmarshalJobStatus(body)
+		if err != nil {
+			return jobs, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// AddTagsToManyTickets adds tags to tickets in background jobs, keeping their other tags.
+// Ids are sent in batches of 100, and a job status is returned for each batch
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#update-many-tickets
+func (z *Client) AddTagsToManyTickets(ctx context.Context, ticketIDs []int64, tags []string) ([]JobStatus, error) {
+	if len(tags) == 0 {
+		return nil, fmt.Errorf("no tags to add")
+	}
+	return z.updateManyTicketsByID(ctx, ticketIDs, TicketUpdate{AdditionalTags: tags})
+}
+
+// RemoveTagsFromManyTickets removes tags from tickets in background jobs, keeping their other tags.
+// Ids are sent in batches of 100, and a job status is returned for each batch
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#update-many-tickets
+func (z *Client) RemoveTagsFromManyTickets(ctx context.Context, ticketIDs []int64, tags []string) ([]JobStatus, error) {
+	if len(tags) == 0 {
+		return nil, fmt.Errorf("no tags to remove")
+	}
+	return z.updateManyTicketsByID(ctx, ticketIDs, TicketUpdate{RemoveTags: tags})
+}
+
+// updateManyTicketsByID applies the same update to tickets in batches of ids.
+// Job statuses of the batches sent before an error are returned with it
+func (z *Client) updateManyTicketsByID(ctx context.Context, ticketIDs []int64, update TicketUpdate) ([]JobStatus, error) {
+	if len(ticketIDs) == 0 {
+		return nil, fmt.Errorf("no tickets to update")
+	}
+
+	var data struct {
+		Ticket TicketUpdate `json:"ticket"`
+	}
+	data.Ticket = update
+
+	var jobs []JobStatus
+	for _, ids := range chunkIDs(ticketIDs, manyLimit) {
+		var req struct {
+			IDs string `url:"ids"`
+		}
+		req.IDs = joinIDs(ids)
+
+		u, err := addOptions("/tickets/update_many.json", req)
+		if err != nil {
+			return jobs, err
+		}
+
+		body, err := z.send(ctx, http.MethodPut, u, data, http.StatusOK)
+		if err != nil {
+			return jobs, err
+		}
+
+		job, err := unmarshalJobStatus(body)
+		if err != nil {
+			return jobs, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// sendManyTickets sends tickets to a *_many endpoint in batches.
+// Job statuses of the batches sent before an error are returned with it
+func (z *Client) sendManyTickets(ctx context.Context, method, path string, tickets []Ticket) ([]JobStatus, error) {
+	var jobs []JobStatus
+	for _, s := range chunk(len(tickets), manyLimit) {
+		var data struct {
+			Tickets []ticketPayload `json:"tickets"`
+		}
+		data.Tickets = ticketPayloads(tickets[s.start:s.end])
+
+		body, err := z.send(ctx, method, path, data, http.StatusOK)
+		if err != nil {
+			return jobs, err
+		}
+
+		job, err := unmarshalJobStatus(body)
+		if err != nil {
+			return jobs, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// UpdateTicket update an existing ticket.
+// Only the fields set in TicketUpdate are sent
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#update-ticket
+func (z *Client) UpdateTicket(ctx context.Context, ticketID int64, update TicketUpdate) (Ticket, error) {
+	var data struct {
+		Ticket TicketUpdate `json:"ticket"`
+	}
+	data.Ticket = update
+
+	return z.updateTicket(ctx, ticketID, data)
+}
+
+// UpdateTicketSafe updates the ticket only if it hasn't been updated since updatedStamp,
+// which is usually UpdatedAt of the ticket the update is based on.
+// If someone else updated it in the meantime, *ConflictError is returned
+// and the update should be retried with a fresh ticket
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#protecting-against-ticket-update-collisions
+func (z *Client) UpdateTicketSafe(ctx context.Context, ticketID int64, update TicketUpdate, updatedStamp time.Time) (Ticket, error) {
+	var data struct {
+		Ticket struct {
+			TicketUpdate
+			SafeUpdate   bool      `json:"safe_update"`
+			UpdatedStamp time.Time `json:"updated_stamp"`
+		} `json:"ticket"`
+	}
+	data.Ticket.TicketUpdate = update
+	data.Ticket.SafeUpdate = true
+	data.Ticket.UpdatedStamp = updatedStamp
+
+	ticket, err := z.updateTicket(ctx, ticketID, data)
+	if zerr, ok := err.(Error); ok && zerr.Status() == http.StatusConflict {
+		return Ticket{}, &ConflictError{Err: zerr}
+	}
+	return ticket, err
+}
+
+func (z *Client) updateTicket(ctx context.Context, ticketID int64, data interface{}) (Ticket, error) {
+	var result struct {
 		Ticket Ticket `json:"ticket"`
 	}
-	data.Ticket = ticket
 
-	body, err := z.post(ctx, "/tickets.json", data)
+	body, err := z.put(ctx, fmt.Sprintf("/tickets/%d.json", ticketID), data)
 	if err != nil {
 		return Ticket{}, err
 	}
@@ -364,3 +1105,35 @@ func (z *Client) CreateTicket(ctx context.Context, ticket Ticket) (Ticket, error
 	}
 	return result.Ticket, nil
 }
+
+// AddTicketEmailCC add users to email CCs of the ticket
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#setting-email-ccs
+func (z *Client) AddTicketEmailCC(ctx context.Context, ticketID int64, ccs ...EmailCC) error {
+	return z.mutateTicketEmailCCs(ctx, ticketID, MutationActionPut, ccs)
+}
+
+// RemoveTicketEmailCC remove users from email CCs of the ticket
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#setting-email-ccs
+func (z *Client) RemoveTicketEmailCC(ctx context.Context, ticketID int64, ccs ...EmailCC) error {
+	return z.mutateTicketEmailCCs(ctx, ticketID, MutationActionDelete, ccs)
+}
+
+// mutateTicketEmailCCs sends only the email_ccs mutation so that
+// other fields of the ticket are left untouched
+func (z *Client) mutateTicketEmailCCs(ctx context.Context, ticketID int64, action string, ccs []EmailCC) error {
+	var data struct {
+		Ticket struct {
+			EmailCCs []EmailCC `json:"email_ccs"`
+		} `json:"ticket"`
+	}
+
+	for _, cc := range ccs {
+		cc.Action = action
+		data.Ticket.EmailCCs = append(data.Ticket.EmailCCs, cc)
+	}
+
+	_, err := z.put(ctx, fmt.Sprintf("/tickets/%d.json", ticketID), data)
+	return err
+}
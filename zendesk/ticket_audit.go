@@ -0,0 +1,72 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TicketAudit is a set of changes made to a ticket by a single update
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/ticket_audits
+type TicketAudit struct {
+	ID        int64              `json:"id"`
+	TicketID  int64              `json:"ticket_id"`
+	AuthorID  int64              `json:"author_id"`
+	Via       *Via               `json:"via,omitempty"`
+	Events    []TicketAuditEvent `json:"events"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+// TicketAuditEvent is a change in a ticket audit, such as a comment or a field change.
+// Fields other than ID and Type are set depending on the type of the event
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/ticket_audits#audit-events
+type TicketAuditEvent struct {
+	ID            int64       `json:"id"`
+	Type          string      `json:"type"`
+	AuthorID      int64       `json:"author_id,omitempty"`
+	Body          string      `json:"body,omitempty"`
+	Public        *bool       `json:"public,omitempty"`
+	FieldName     string      `json:"field_name,omitempty"`
+	Value         interface{} `json:"value,omitempty"`
+	PreviousValue interface{} `json:"previous_value,omitempty"`
+	Via           *Via        `json:"via,omitempty"`
+}
+
+// TicketAuditAPI an interface containing ticket audit related methods
+type TicketAuditAPI interface {
+	GetTicketAudits(ctx context.Context, ticketID int64, opts *PageOptions) ([]TicketAudit, Page, error)
+}
+
+// GetTicketAudits gets the audits of a specified ticket
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/ticket_audits#list-audits-for-a-ticket
+func (z *Client) GetTicketAudits(ctx context.Context, ticketID int64, opts *PageOptions) ([]TicketAudit, Page, error) {
+	var data struct {
+		Audits []TicketAudit `json:"audits"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &PageOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/tickets/%d/audits.json", ticketID), tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Audits, data.Page, nil
+}
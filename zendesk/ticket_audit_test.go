@@ -0,0 +1,33 @@
+package zendesk
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestGetTicketAudits(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_audits.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	audits, _, err := client.GetTicketAudits(ctx, 2, nil)
+	if err != nil {
+		t.Fatalf("Failed to get ticket audits: %s", err)
+	}
+
+	if len(audits) != 2 {
+		t.Fatalf("expected length of audits is 2, but got %d", len(audits))
+	}
+
+	if audits[0].Via == nil || audits[0].Via.Channel != "email" {
+		t.Fatalf("expected the first audit to come via email, but got %v", audits[0].Via)
+	}
+
+	event := audits[1].Events[0]
+	if event.Via == nil || event.Via.Channel != "api" {
+		t.Fatalf("expected the event to come via api, but got %v", event.Via)
+	}
+	if event.FieldName != "status" || event.Value != "pending" || event.PreviousValue != "open" {
+		t.Fatalf("unexpected change event %v", event)
+	}
+}
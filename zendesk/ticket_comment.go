@@ -8,7 +8,7 @@ import (
 )
 
 // TicketComment is a struct for ticket comment payload
-// Via and Metadata are currently unused
+// Metadata is currently unused
 // https://developer.zendesk.com/rest_api/docs/support/ticket_comments
 type TicketComment struct {
 	ID          int64        `json:"id,omitempty"`
@@ -19,7 +19,30 @@ type TicketComment struct {
 	Public      *bool        `json:"public,omitempty"`
 	AuthorID    int64        `json:"author_id,omitempty"`
 	Attachments []Attachment `json:"attachments,omitempty"`
-	CreatedAt   time.Time    `json:"created_at,omitempty"`
+
+	// Uploads is tokens of files uploaded by UploadAttachment to attach to the comment.
+	// It's write only and isn't returned by the API
+	Uploads []string `json:"uploads,omitempty"`
+
+	Via       *Via      `json:"via,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// TicketCommentListOptions is options for GetTicketComments
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/ticket_comments#list-comments
+type TicketCommentListOptions struct {
+	PageOptions
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// TicketCommentAPI an interface containing all ticket comment related methods
+type TicketCommentAPI interface {
+	CreateTicketComment(ctx context.Context, ticketID int64, ticketComment TicketComment, opts ...RequestOption) error
+	ListTicketComments(ctx context.Context, ticketID int64) ([]TicketComment, error)
+	GetTicketComments(ctx context.Context, ticketID int64, opts *TicketCommentListOptions) ([]TicketComment, Page, error)
+	GetAllTicketComments(ctx context.Context, ticketID int64) ([]TicketComment, error)
+	EachTicketComment(ctx context.Context, ticketID int64, fn func(TicketComment) error) error
 }
 
 // NewPublicComment generates and returns a new TicketComment
@@ -44,10 +67,11 @@ func NewPrivateTicketComment(body string, authorID int64) TicketComment {
 	}
 }
 
-// CreateTicketComment creates a comment on a ticket
+// CreateTicketComment creates a comment on a ticket.
+// RequestOption such as WithOnBehalfOf can be passed to impersonate the author
 //
 // ref: https://developer.zendesk.com/rest_api/docs/support/ticket_comments#create-ticket-comment
-func (z *Client) CreateTicketComment(ctx context.Context, ticketID int64, ticketComment TicketComment) error {
+func (z *Client) CreateTicketComment(ctx context.Context, ticketID int64, ticketComment TicketComment, opts ...RequestOption) error {
 	type comment struct {
 		Ticket struct {
 			TicketComment TicketComment `json:"comment"`
@@ -57,7 +81,7 @@ func (z *Client) CreateTicketComment(ctx context.Context, ticketID int64, ticket
 	data := &comment{}
 	data.Ticket.TicketComment = ticketComment
 
-	_, err := z.put(ctx, fmt.Sprintf("/tickets/%d.json", ticketID), data)
+	_, err := z.put(ctx, fmt.Sprintf("/tickets/%d.json", ticketID), data, opts...)
 	if err != nil {
 		return err
 	}
@@ -85,3 +109,71 @@ func (z *Client) ListTicketComments(ctx context.Context, ticketID int64) ([]Tick
 
 	return result.TicketComments, err
 }
+
+// GetTicketComments gets a page of comments for a specified ticket
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/ticket_comments#list-comments
+func (z *Client) GetTicketComments(ctx context.Context, ticketID int64, opts *TicketCommentListOptions) ([]TicketComment, Page, error) {
+	var result struct {
+		TicketComments []TicketComment `json:"comments"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &TicketCommentListOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/tickets/%d/comments.json", ticketID), tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	return result.TicketComments, result.Page, nil
+}
+
+// EachTicketComment calls fn for every comment of a specified ticket in order,
+// following pages of GetTicketComments until the last one.
+// Iteration stops at the first error returned by fn, and the error is returned
+func (z *Client) EachTicketComment(ctx context.Context, ticketID int64, fn func(TicketComment) error) error {
+	opts := &TicketCommentListOptions{PageOptions: PageOptions{PerPage: MaxPerPage}}
+	for {
+		comments, page, err := z.GetTicketComments(ctx, ticketID, opts)
+		if err != nil {
+			return err
+		}
+		for _, comment := range comments {
+			if err := fn(comment); err != nil {
+				return err
+			}
+		}
+		next, ok := page.NextPageNumber()
+		if !ok {
+			return nil
+		}
+		opts.Page = next
+	}
+}
+
+// GetAllTicketComments gets every comment of a specified ticket across all pages
+func (z *Client) GetAllTicketComments(ctx context.Context, ticketID int64) ([]TicketComment, error) {
+	var comments []TicketComment
+	err := z.EachTicketComment(ctx, ticketID, func(comment TicketComment) error {
+		comments = append(comments, comment)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
@@ -1,7 +1,10 @@
 package zendesk
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -51,3 +54,94 @@ func TestListTicketComments(t *testing.T) {
 		t.Fatalf("Returned ticket comments does not have the expected length %d. Ticket comments length is %d", expectedLength, len(ticketComments))
 	}
 }
+
+func TestGetTicketComments(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_comments.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticketComments, _, err := client.GetTicketComments(ctx, 2, &TicketCommentListOptions{SortOrder: "desc"})
+	if err != nil {
+		t.Fatalf("Failed to get ticket comments: %s", err)
+	}
+
+	if len(ticketComments) != 2 {
+		t.Fatalf("expected length of ticket comments is 2, but got %d", len(ticketComments))
+	}
+}
+
+func TestGetAllTicketComments(t *testing.T) {
+	var mockAPI *httptest.Server
+	mockAPI = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tickets/2/comments.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		switch r.URL.Query().Get("page") {
+		case "":
+			fmt.Fprintf(w, `{"comments":[{"id":1},{"id":2}],"next_page":"%s/tickets/2/comments.json?page=2&per_page=100","previous_page":null,"count":3}`, mockAPI.URL)
+		case "2":
+			fmt.Fprintf(w, `{"comments":[{"id":3}],"next_page":null,"previous_page":"%s/tickets/2/comments.json?page=1&per_page=100","count":3}`, mockAPI.URL)
+		default:
+			t.Fatalf("Unexpected page %s", r.URL.Query().Get("page"))
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticketComments, err := client.GetAllTicketComments(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to get all ticket comments: %s", err)
+	}
+
+	if len(ticketComments) != 3 {
+		t.Fatalf("expected length of ticket comments is 3, but got %d", len(ticketComments))
+	}
+	for i, comment := range ticketComments {
+		if comment.ID != int64(i+1) {
+			t.Fatalf("expected comment id %d at index %d, but got %d", i+1, i, comment.ID)
+		}
+	}
+}
+
+func TestEachTicketCommentStopsOnError(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_comments.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	stop := errors.New("stop")
+	var visited int
+	err := client.EachTicketComment(ctx, 2, func(comment TicketComment) error {
+		visited++
+		return stop
+	})
+	if err != stop {
+		t.Fatalf("expected the callback error, but got %v", err)
+	}
+	if visited != 1 {
+		t.Fatalf("expected 1 visited comment, but got %d", visited)
+	}
+}
+
+func TestListTicketCommentsFromSideConversation(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket_comments_side_conversation.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticketComments, err := client.ListTicketComments(ctx, 2)
+	if err != nil {
+		t.Fatalf("Failed to list ticket comments: %s", err)
+	}
+
+	via := ticketComments[0].Via
+	if via == nil || via.Channel != ViaChannelSideConversation {
+		t.Fatalf("expected the comment to come via side conversation, but got %v", via)
+	}
+	id, ok := via.SideConversationID()
+	if !ok || id != "8566255a-8a22-11ea-9f42-b5d6f5a4b1c2" {
+		t.Fatalf("side conversation id expect 8566255a-8a22-11ea-9f42-b5d6f5a4b1c2, but got %s", id)
+	}
+
+	if _, ok := (Via{Channel: "email"}).SideConversationID(); ok {
+		t.Fatal("expected no side conversation id for an email comment")
+	}
+}
@@ -0,0 +1,74 @@
+package zendesk
+
+// CustomField returns the custom field of the id, and false if the ticket doesn't have it
+func (t Ticket) CustomField(id int64) (CustomField, bool) {
+	for _, cf := range t.CustomFields {
+		if cf.ID == id {
+			return cf, true
+		}
+	}
+	return CustomField{}, false
+}
+
+// CustomFieldString returns the value of a text, textarea, dropdown or regexp field.
+// It returns false if the ticket doesn't have the field or the value isn't a string
+func (t Ticket) CustomFieldString(id int64) (string, bool) {
+	cf, ok := t.CustomField(id)
+	if !ok {
+		return "", false
+	}
+	v, ok := cf.Value.(string)
+	return v, ok
+}
+
+// CustomFieldStrings returns the value of a multi-select field.
+// It returns false if the ticket doesn't have the field or the value isn't a list of strings
+func (t Ticket) CustomFieldStrings(id int64) ([]string, bool) {
+	cf, ok := t.CustomField(id)
+	if !ok {
+		return nil, false
+	}
+	v, ok := cf.Value.([]string)
+	return v, ok
+}
+
+// CustomFieldBool returns the value of a checkbox field.
+// It returns false if the ticket doesn't have the field or the value isn't a bool
+func (t Ticket) CustomFieldBool(id int64) (bool, bool) {
+	cf, ok := t.CustomField(id)
+	if !ok {
+		return false, false
+	}
+	v, ok := cf.Value.(bool)
+	return v, ok
+}
+
+// CustomFieldInt64 returns the value of an integer field.
+// It returns false if the ticket doesn't have the field or the value isn't an integer
+func (t Ticket) CustomFieldInt64(id int64) (int64, bool) {
+	cf, ok := t.CustomField(id)
+	if !ok {
+		return 0, false
+	}
+	switch v := cf.Value.(type) {
+	case int64:
+		return v, true
+	case int:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	}
+	return 0, false
+}
+
+// SetCustomField sets the value of the custom field, appending the field if the ticket doesn't have it.
+// The value should be string, []string, bool, int64, float64 or nil to clear the field
+func (t *Ticket) SetCustomField(id int64, value interface{}) {
+	for i := range t.CustomFields {
+		if t.CustomFields[i].ID == id {
+			t.CustomFields[i].Value = value
+			return
+		}
+	}
+	t.CustomFields = append(t.CustomFields, CustomField{ID: id, Value: value})
+}
@@ -0,0 +1,57 @@
+package zendesk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTicketCustomFieldAccessors(t *testing.T) {
+	ticket := Ticket{CustomFields: []CustomField{
+		{ID: 1, Value: "premium"},
+		{ID: 2, Value: []string{"printer", "fire"}},
+		{ID: 3, Value: true},
+		{ID: 4, Value: int64(42)},
+		{ID: 5, Value: nil},
+	}}
+
+	if v, ok := ticket.CustomFieldString(1); !ok || v != "premium" {
+		t.Fatalf("string field expect premium, but got %q (%v)", v, ok)
+	}
+	if v, ok := ticket.CustomFieldStrings(2); !ok || !reflect.DeepEqual(v, []string{"printer", "fire"}) {
+		t.Fatalf("strings field expect [printer fire], but got %v (%v)", v, ok)
+	}
+	if v, ok := ticket.CustomFieldBool(3); !ok || !v {
+		t.Fatalf("bool field expect true, but got %v (%v)", v, ok)
+	}
+	if v, ok := ticket.CustomFieldInt64(4); !ok || v != 42 {
+		t.Fatalf("int64 field expect 42, but got %d (%v)", v, ok)
+	}
+
+	// Mismatched types, null values and missing fields are not found
+	if _, ok := ticket.CustomFieldString(3); ok {
+		t.Fatal("expected a bool field not to be read as string")
+	}
+	if _, ok := ticket.CustomFieldString(5); ok {
+		t.Fatal("expected a null field not to be read as string")
+	}
+	if _, ok := ticket.CustomFieldBool(99); ok {
+		t.Fatal("expected a missing field not to be found")
+	}
+}
+
+func TestTicketSetCustomField(t *testing.T) {
+	ticket := Ticket{CustomFields: []CustomField{{ID: 1, Value: "basic"}}}
+
+	ticket.SetCustomField(1, "premium")
+	ticket.SetCustomField(2, []string{"printer"})
+
+	if len(ticket.CustomFields) != 2 {
+		t.Fatalf("expected length of custom fields is 2, but got %d", len(ticket.CustomFields))
+	}
+	if v, ok := ticket.CustomFieldString(1); !ok || v != "premium" {
+		t.Fatalf("updated field expect premium, but got %q (%v)", v, ok)
+	}
+	if v, ok := ticket.CustomFieldStrings(2); !ok || !reflect.DeepEqual(v, []string{"printer"}) {
+		t.Fatalf("appended field expect [printer], but got %v (%v)", v, ok)
+	}
+}
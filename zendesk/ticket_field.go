@@ -53,17 +53,46 @@ type TicketFieldAPI interface {
 	GetTicketField(ctx context.Context, ticketID int64) (TicketField, error)
 	UpdateTicketField(ctx context.Context, ticketID int64, field TicketField) (TicketField, error)
 	DeleteTicketField(ctx context.Context, ticketID int64) error
+	ResolveCustomFieldValue(ctx context.Context, fieldID int64, value string) (string, error)
 }
 
 // GetTicketFields fetches ticket field list
 // ref: https://developer.zendesk.com/rest_api/docs/core/ticket_fields#list-ticket-fields
 func (z *Client) GetTicketFields(ctx context.Context) ([]TicketField, Page, error) {
+	return z.getTicketFields(ctx, "/ticket_fields.json")
+}
+
+// getAllTicketFields collects ticket fields of every page
+func (z *Client) getAllTicketFields(ctx context.Context) ([]TicketField, error) {
+	var all []TicketField
+	opts := PageOptions{PerPage: MaxPerPage}
+	for {
+		u, err := addOptions("/ticket_fields.json", opts)
+		if err != nil {
+			return nil, err
+		}
+
+		fields, page, err := z.getTicketFields(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, fields...)
+
+		next, ok := page.NextPageNumber()
+		if !ok {
+			return all, nil
+		}
+		opts.Page = next
+	}
+}
+
+func (z *Client) getTicketFields(ctx context.Context, path string) ([]TicketField, Page, error) {
 	var data struct {
 		TicketFields []TicketField `json:"ticket_fields"`
 		Page
 	}
 
-	body, err := z.get(ctx, "/ticket_fields.json")
+	body, err := z.get(ctx, path)
 	if err != nil {
 		return []TicketField{}, Page{}, err
 	}
@@ -126,6 +155,7 @@ func (z *Client) UpdateTicketField(ctx context.Context, ticketID int64, field Ti
 	data.TicketField = field
 
 	body, err := z.put(ctx, fmt.Sprintf("/ticket_fields/%d.json", ticketID), data)
+	z.fieldOptions.invalidate(ticketID)
 
 	if err != nil {
 		return TicketField{}, err
@@ -143,6 +173,7 @@ func (z *Client) UpdateTicketField(ctx context.Context, ticketID int64, field Ti
 // ref: https://developer.zendesk.com/rest_api/docs/support/ticket_fields#delete-ticket-field
 func (z *Client) DeleteTicketField(ctx context.Context, ticketID int64) error {
 	err := z.delete(ctx, fmt.Sprintf("/ticket_fields/%d.json", ticketID))
+	z.fieldOptions.invalidate(ticketID)
 
 	if err != nil {
 		return err
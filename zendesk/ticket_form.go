@@ -3,9 +3,15 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 )
 
+// ErrTicketFormNotFound is returned by GetTicketFormByName when no form has the name,
+// and by GetDefaultTicketForm when the brand has no form
+var ErrTicketFormNotFound = errors.New("ticket form not found")
+
 // TicketForm is JSON payload struct
 type TicketForm struct {
 	ID                 int64   `json:"id,omitempty"`
@@ -41,6 +47,9 @@ type TicketFormAPI interface {
 	DeleteTicketForm(ctx context.Context, id int64) error
 	UpdateTicketForm(ctx context.Context, id int64, form TicketForm) (TicketForm, error)
 	GetTicketForm(ctx context.Context, id int64) (TicketForm, error)
+	GetTicketFieldsForForm(ctx context.Context, formID int64) ([]TicketField, error)
+	GetTicketFormByName(ctx context.Context, name string) (TicketForm, error)
+	GetDefaultTicketForm(ctx context.Context, brandID int64) (TicketForm, error)
 }
 
 // GetTicketForms fetches ticket forms
@@ -143,3 +152,127 @@ func (z *Client) DeleteTicketForm(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+// GetTicketFieldsForForm returns the ticket fields of the form in the order of its ticket_field_ids.
+// Ids of fields which no longer exist are skipped
+func (z *Client) GetTicketFieldsForForm(ctx context.Context, formID int64) ([]TicketField, error) {
+	form, err := z.GetTicketForm(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := z.getAllTicketFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return fieldsOfForm(form, fields), nil
+}
+
+// fieldsOfForm picks fields of the form in the order of its ticket_field_ids
+func fieldsOfForm(form TicketForm, fields []TicketField) []TicketField {
+	byID := make(map[int64]TicketField, len(fields))
+	for _, f := range fields {
+		byID[f.ID] = f
+	}
+
+	ordered := make([]TicketField, 0, len(form.TicketFieldIDs))
+	for _, id := range form.TicketFieldIDs {
+		if f, ok := byID[id]; ok {
+			ordered = append(ordered, f)
+		}
+	}
+	return ordered
+}
+
+// GetTicketFormByName returns the ticket form whose name matches case-insensitively,
+// so that forms can be referenced by name across environments.
+// It returns ErrTicketFormNotFound if there is no such form
+func (z *Client) GetTicketFormByName(ctx context.Context, name string) (TicketForm, error) {
+	return getTicketFormByName(ctx, z, name)
+}
+
+// getTicketFormByName looks up the form with forms listed by api,
+// so that CachedClient can list them through its cache
+func getTicketFormByName(ctx context.Context, api TicketFormAPI, name string) (TicketForm, error) {
+	opts := &TicketFormListOptions{PageOptions: PageOptions{PerPage: MaxPerPage}}
+	for {
+		forms, page, err := api.GetTicketForms(ctx, opts)
+		if err != nil {
+			return TicketForm{}, err
+		}
+		for _, form := range forms {
+			if strings.EqualFold(form.Name, name) {
+				return form, nil
+			}
+		}
+		next, ok := page.NextPageNumber()
+		if !ok {
+			return TicketForm{}, ErrTicketFormNotFound
+		}
+		opts.Page = next
+	}
+}
+
+// AvailableInBrand reports whether the form can be used in the brand
+func (f TicketForm) AvailableInBrand(brandID int64) bool {
+	if f.InAllBrands {
+		return true
+	}
+	for _, id := range f.RestrictedBrandIDs {
+		if id == brandID {
+			return true
+		}
+	}
+	return false
+}
+
+// GetDefaultTicketForm returns the form which tickets of the brand get by default.
+// It's the default form of the account if it's available in the brand, and
+// the first active form of the brand by position otherwise.
+// It returns ErrTicketFormNotFound if the brand has no active form
+func (z *Client) GetDefaultTicketForm(ctx context.Context, brandID int64) (TicketForm, error) {
+	return getDefaultTicketForm(ctx, z, brandID)
+}
+
+// getDefaultTicketForm finds the default form with forms listed by api
+func getDefaultTicketForm(ctx context.Context, api TicketFormAPI, brandID int64) (TicketForm, error) {
+	var forms []TicketForm
+	opts := &TicketFormListOptions{PageOptions: PageOptions{PerPage: MaxPerPage}, Active: true}
+	for {
+		list, page, err := api.GetTicketForms(ctx, opts)
+		if err != nil {
+			return TicketForm{}, err
+		}
+		forms = append(forms, list...)
+		next, ok := page.NextPageNumber()
+		if !ok {
+			break
+		}
+		opts.Page = next
+	}
+
+	return defaultTicketForm(forms, brandID)
+}
+
+func defaultTicketForm(forms []TicketForm, brandID int64) (TicketForm, error) {
+	var (
+		first TicketForm
+		found bool
+	)
+	for _, form := range forms {
+		if !form.Active || !form.AvailableInBrand(brandID) {
+			continue
+		}
+		if form.Default {
+			return form, nil
+		}
+		if !found || form.Position < first.Position {
+			first, found = form, true
+		}
+	}
+
+	if !found {
+		return TicketForm{}, ErrTicketFormNotFound
+	}
+	return first, nil
+}
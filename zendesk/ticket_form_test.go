@@ -3,6 +3,7 @@ package zendesk
 import (
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 )
 
@@ -115,3 +116,95 @@ func TestUpdateTicketFormFailure(t *testing.T) {
 		t.Fatal("Client did not return error when api failed")
 	}
 }
+
+func TestGetTicketFieldsForForm(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ticket_forms/47.json":
+			w.Write([]byte(`{"ticket_form":{"id":47,"name":"Snowboard Problem","ticket_field_ids":[360011747974,360011737434,999,360011737494]}}`))
+		case "/ticket_fields.json":
+			w.Write(readFixture(filepath.Join(http.MethodGet, "ticket_fields.json")))
+		default:
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	fields, err := client.GetTicketFieldsForForm(ctx, 47)
+	if err != nil {
+		t.Fatalf("Failed to get ticket fields for form: %s", err)
+	}
+
+	expected := []int64{360011747974, 360011737434, 360011737494}
+	if len(fields) != len(expected) {
+		t.Fatalf("expected length of ticket fields is %d, but got %d", len(expected), len(fields))
+	}
+	for i, id := range expected {
+		if fields[i].ID != id {
+			t.Fatalf("ticket field %d expect id %d, but got %d", i, id, fields[i].ID)
+		}
+	}
+}
+
+func TestGetTicketFormByName(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ticket_forms":[{"id":360000389572,"name":"Default Ticket Form"},{"id":360000389592,"name":"Billing Request"}],"next_page":null,"previous_page":null,"count":2}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	form, err := client.GetTicketFormByName(ctx, "billing request")
+	if err != nil {
+		t.Fatalf("Failed to get ticket form by name: %s", err)
+	}
+	if form.ID != 360000389592 {
+		t.Fatalf("expected ticket form id 360000389592, but got %d", form.ID)
+	}
+
+	_, err = client.GetTicketFormByName(ctx, "Refund")
+	if err != ErrTicketFormNotFound {
+		t.Fatalf("expected ErrTicketFormNotFound, but got %v", err)
+	}
+}
+
+func TestGetDefaultTicketForm(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("active") != "true" {
+			t.Fatalf("Request does not have expected active param: %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"ticket_forms":[
+			{"id":1,"name":"Default Ticket Form","position":1,"active":true,"default":true,"restricted_brand_ids":[100]},
+			{"id":2,"name":"Retail Returns","position":3,"active":true,"restricted_brand_ids":[200]},
+			{"id":3,"name":"Retail Support","position":2,"active":true,"restricted_brand_ids":[200,300]},
+			{"id":4,"name":"Everywhere","position":5,"active":true,"in_all_brands":true}
+		],"next_page":null,"previous_page":null,"count":4}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	cases := []struct {
+		brandID  int64
+		expected int64
+	}{
+		{100, 1},
+		{200, 3},
+		{400, 4},
+	}
+	for _, c := range cases {
+		form, err := client.GetDefaultTicketForm(ctx, c.brandID)
+		if err != nil {
+			t.Fatalf("Failed to get default ticket form: %s", err)
+		}
+		if form.ID != c.expected {
+			t.Fatalf("expected ticket form id %d for brand %d, but got %d", c.expected, c.brandID, form.ID)
+		}
+	}
+}
+
+func TestGetDefaultTicketFormNotFound(t *testing.T) {
+	_, err := defaultTicketForm([]TicketForm{{ID: 1, Active: true, RestrictedBrandIDs: []int64{100}}}, 200)
+	if err != ErrTicketFormNotFound {
+		t.Fatalf("expected ErrTicketFormNotFound, but got %v", err)
+	}
+}
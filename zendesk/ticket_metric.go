@@ -0,0 +1,102 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/tylerconlee/zendesk-go/zendesk/sideload"
+)
+
+// ErrTicketMetricNotFound is returned by GetTicketWithMetrics when the response has no metric set of the ticket
+var ErrTicketMetricNotFound = errors.New("ticket metric not found")
+
+// TicketMetricDuration is a duration in minutes of calendar and business hours
+type TicketMetricDuration struct {
+	Calendar int64 `json:"calendar"`
+	Business int64 `json:"business"`
+}
+
+// TicketMetric is struct for ticket metric payload
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/ticket_metrics#json-format
+type TicketMetric struct {
+	ID                           int64                `json:"id,omitempty"`
+	URL                          string               `json:"url,omitempty"`
+	TicketID                     int64                `json:"ticket_id,omitempty"`
+	GroupStations                int64                `json:"group_stations,omitempty"`
+	AssigneeStations             int64                `json:"assignee_stations,omitempty"`
+	Reopens                      int64                `json:"reopens,omitempty"`
+	Replies                      int64                `json:"replies,omitempty"`
+	AssigneeUpdatedAt            *time.Time           `json:"assignee_updated_at,omitempty"`
+	RequesterUpdatedAt           *time.Time           `json:"requester_updated_at,omitempty"`
+	StatusUpdatedAt              *time.Time           `json:"status_updated_at,omitempty"`
+	InitiallyAssignedAt          *time.Time           `json:"initially_assigned_at,omitempty"`
+	AssignedAt                   *time.Time           `json:"assigned_at,omitempty"`
+	SolvedAt                     *time.Time           `json:"solved_at,omitempty"`
+	LatestCommentAddedAt         *time.Time           `json:"latest_comment_added_at,omitempty"`
+	ReplyTimeInMinutes           TicketMetricDuration `json:"reply_time_in_minutes"`
+	FirstResolutionTimeInMinutes TicketMetricDuration `json:"first_resolution_time_in_minutes"`
+	FullResolutionTimeInMinutes  TicketMetricDuration `json:"full_resolution_time_in_minutes"`
+	AgentWaitTimeInMinutes       TicketMetricDuration `json:"agent_wait_time_in_minutes"`
+	RequesterWaitTimeInMinutes   TicketMetricDuration `json:"requester_wait_time_in_minutes"`
+	OnHoldTimeInMinutes          TicketMetricDuration `json:"on_hold_time_in_minutes"`
+	CreatedAt                    *time.Time           `json:"created_at,omitempty"`
+	UpdatedAt                    *time.Time           `json:"updated_at,omitempty"`
+}
+
+// GetTicketWithMetrics gets a specified ticket with its metrics sideloaded by metric_sets.
+// It returns the ticket with ErrTicketMetricNotFound if the ticket has no metric set
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/side_loading#supported-endpoints
+func (z *Client) GetTicketWithMetrics(ctx context.Context, ticketID int64) (Ticket, TicketMetric, error) {
+	var metrics map[int64]TicketMetric
+
+	ticket, err := z.GetTicket(ctx, ticketID, IncludeTicketMetrics(&metrics))
+	if err != nil {
+		return Ticket{}, TicketMetric{}, err
+	}
+
+	m, ok := metrics[ticketID]
+	if !ok {
+		return ticket, TicketMetric{}, ErrTicketMetricNotFound
+	}
+	return ticket, m, nil
+}
+
+// ticketMetricSets is a sideloader of metric_sets which indexes metrics by ticket id
+type ticketMetricSets struct {
+	metrics *map[int64]TicketMetric
+}
+
+// IncludeTicketMetrics sideloads the metrics of the tickets in the result with
+// include=metric_sets, and stores them in metrics keyed by ticket id.
+// Tickets without a metric set have no entry
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/side_loading#supported-endpoints
+func IncludeTicketMetrics(metrics *map[int64]TicketMetric) sideload.SideLoader {
+	return &ticketMetricSets{metrics: metrics}
+}
+
+func (s *ticketMetricSets) Key() string {
+	return "metric_sets"
+}
+
+func (s *ticketMetricSets) Unmarshal(b []byte) error {
+	var data struct {
+		MetricSets []TicketMetric `json:"metric_sets"`
+	}
+
+	err := json.Unmarshal(b, &data)
+	if err != nil {
+		return err
+	}
+
+	metrics := make(map[int64]TicketMetric, len(data.MetricSets))
+	for _, m := range data.MetricSets {
+		metrics[m.TicketID] = m
+	}
+	*s.metrics = metrics
+	return nil
+}
@@ -0,0 +1,144 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// MetricEventSLA is the SLA target which applied to an apply_sla metric event
+type MetricEventSLA struct {
+	Target        int64 `json:"target,omitempty"`
+	BusinessHours bool  `json:"business_hours,omitempty"`
+	Policy        struct {
+		ID          int64  `json:"id,omitempty"`
+		Title       string `json:"title,omitempty"`
+		Description string `json:"description,omitempty"`
+	} `json:"policy,omitempty"`
+}
+
+// MetricEvent is struct for ticket metric event payload.
+// Metric is e.g. "reply_time" or "resolution_time", and Type is e.g.
+// "activate", "fulfill", "apply_sla" or "breach".
+// SLA is set only for apply_sla events and Status only for update_status events
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/ticket_metric_events#json-format
+type MetricEvent struct {
+	ID         int64                 `json:"id,omitempty"`
+	TicketID   int64                 `json:"ticket_id,omitempty"`
+	Metric     string                `json:"metric,omitempty"`
+	InstanceID int64                 `json:"instance_id,omitempty"`
+	Type       string                `json:"type,omitempty"`
+	Time       time.Time             `json:"time,omitempty"`
+	SLA        *MetricEventSLA       `json:"sla,omitempty"`
+	Status     *TicketMetricDuration `json:"status,omitempty"`
+	Deleted    bool                  `json:"deleted,omitempty"`
+}
+
+// MetricEventListOptions is options for GetIncrementalTicketMetricEvents.
+// Use SetNextPage with the returned next page to get the following one
+type MetricEventListOptions struct {
+	// StartTime is a UNIX timestamp of when the export should begin
+	StartTime int64 `url:"start_time"`
+}
+
+// SetNextPage sets StartTime to start_time of the next page URL
+// returned by GetIncrementalTicketMetricEvents
+func (o *MetricEventListOptions) SetNextPage(nextPage string) error {
+	startTime, err := metricEventStartTime(nextPage)
+	if err != nil {
+		return err
+	}
+	o.StartTime = startTime
+	return nil
+}
+
+// metricEventStartTime extracts start_time from the next page URL
+func metricEventStartTime(nextPage string) (int64, error) {
+	u, err := url.Parse(nextPage)
+	if err != nil {
+		return 0, err
+	}
+	v := u.Query().Get("start_time")
+	startTime, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("next page %q has no valid start_time", nextPage)
+	}
+	return startTime, nil
+}
+
+// TicketMetricEventAPI an interface containing ticket metric event methods
+type TicketMetricEventAPI interface {
+	GetIncrementalTicketMetricEvents(ctx context.Context, opts *MetricEventListOptions) ([]MetricEvent, string, bool, error)
+}
+
+// GetIncrementalTicketMetricEvents get ticket metric events which occurred since StartTime.
+// It returns the URL of the next page and whether it's the end of stream.
+// The export has no end_of_stream, so it ends when there is no next page
+// or the next page doesn't advance start_time
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/ticket_metric_events#list-ticket-metric-events
+func (z *Client) GetIncrementalTicketMetricEvents(ctx context.Context, opts *MetricEventListOptions) ([]MetricEvent, string, bool, error) {
+	var data struct {
+		MetricEvents []MetricEvent `json:"ticket_metric_events"`
+		NextPage     string        `json:"next_page"`
+		EoS          *bool         `json:"end_of_stream"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &MetricEventListOptions{}
+	}
+
+	u, err := addOptions("/incremental/ticket_metric_events.json", tmp)
+	if err != nil {
+		return nil, "", true, err
+	}
+
+	err = z.getJSON(ctx, u, &data)
+	if err != nil {
+		return nil, "", true, err
+	}
+	return data.MetricEvents, data.NextPage, metricEventsEndOfStream(data.EoS, data.NextPage, tmp.StartTime), nil
+}
+
+// metricEventsEndOfStream tells whether a page of ticket metric events export is the last one.
+// Pages have at most 100 events, so the page size can't tell it unlike other time based exports
+func metricEventsEndOfStream(eos *bool, nextPage string, startTime int64) bool {
+	if eos != nil {
+		return *eos
+	}
+	if nextPage == "" {
+		return true
+	}
+	next, err := metricEventStartTime(nextPage)
+	return err != nil || next <= startTime
+}
+
+// TimeToBreach returns the time left before the SLA target applied by metric is breached,
+// which is negative once it's breached. metric should be an apply_sla event.
+// A target in business hours is counted in business time of schedule, so
+// nights, weekends and holidays of the schedule don't count.
+// It returns false if metric has no SLA target or the schedule has no business hours.
+// Pauses of the metric after the event aren't taken into account
+func TimeToBreach(metric MetricEvent, schedule Schedule, now time.Time) (time.Duration, bool) {
+	if metric.Type != "apply_sla" || metric.SLA == nil {
+		return 0, false
+	}
+	target := time.Duration(metric.SLA.Target) * time.Minute
+
+	if !metric.SLA.BusinessHours {
+		return metric.Time.Add(target).Sub(now), true
+	}
+
+	deadline, ok := schedule.addBusinessTime(metric.Time, target)
+	if !ok {
+		return 0, false
+	}
+	if now.Before(deadline) {
+		return schedule.businessTimeBetween(now, deadline), true
+	}
+	return -schedule.businessTimeBetween(deadline, now), true
+}
@@ -0,0 +1,206 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestGetIncrementalTicketMetricEvents(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incremental/ticket_metric_events.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		if st := r.URL.Query().Get("start_time"); st != "1586400000" {
+			t.Fatalf(`start_time param expect "1586400000", but got "%s"`, st)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "ticket_metric_events.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	events, nextPage, eos, err := client.GetIncrementalTicketMetricEvents(ctx, &MetricEventListOptions{StartTime: 1586400000})
+	if err != nil {
+		t.Fatalf("Failed to get ticket metric events: %s", err)
+	}
+
+	if len(events) != 5 {
+		t.Fatalf("expected length of ticket metric events is 5, but got %d", len(events))
+	}
+
+	sla := events[1]
+	if sla.Metric != "reply_time" || sla.Type != "apply_sla" || sla.SLA == nil {
+		t.Fatalf("apply_sla event was not parsed as expected: %v", sla)
+	}
+	if sla.SLA.Target != 60 || sla.SLA.Policy.ID != 360000130413 {
+		t.Fatalf("SLA of event was not parsed as expected: %v", sla.SLA)
+	}
+
+	status := events[4]
+	if status.Metric != "resolution_time" || status.Status == nil {
+		t.Fatalf("update_status event was not parsed as expected: %v", status)
+	}
+	if status.Status.Calendar != 697 || status.Status.Business != 212 {
+		t.Fatalf("status of event was not parsed as expected: %v", status.Status)
+	}
+	if events[0].SLA != nil || events[0].Status != nil {
+		t.Fatalf("activate event should have neither SLA nor status: %v", events[0])
+	}
+
+	if nextPage == "" || eos {
+		t.Fatalf("expected next page URL and not end of stream, but got %q and %t", nextPage, eos)
+	}
+}
+
+func TestGetIncrementalTicketMetricEventsPages(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("start_time") {
+		case "1586400000":
+			w.Write([]byte(`{"ticket_metric_events":[{"id":1}],"next_page":"https://example.zendesk.com/api/v2/incremental/ticket_metric_events.json?start_time=1586505600","count":1,"end_time":1586505600}`))
+		case "1586505600":
+			w.Write([]byte(`{"ticket_metric_events":[{"id":2}],"next_page":"https://example.zendesk.com/api/v2/incremental/ticket_metric_events.json?start_time=1586505600","count":1,"end_time":1586505600}`))
+		default:
+			t.Fatalf("Unexpected query %s", r.URL.RawQuery)
+		}
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	opts := &MetricEventListOptions{StartTime: 1586400000}
+	var ids []int64
+	for {
+		events, nextPage, eos, err := client.GetIncrementalTicketMetricEvents(ctx, opts)
+		if err != nil {
+			t.Fatalf("Failed to get ticket metric events: %s", err)
+		}
+		for _, e := range events {
+			ids = append(ids, e.ID)
+		}
+		if eos {
+			break
+		}
+		if err := opts.SetNextPage(nextPage); err != nil {
+			t.Fatalf("Failed to set next page: %s", err)
+		}
+	}
+
+	if len(ids) != 2 || ids[0] != 1 || ids[1] != 2 {
+		t.Fatalf("expected events 1 and 2, but got %v", ids)
+	}
+}
+
+func TestMetricEventListOptionsSetNextPage(t *testing.T) {
+	var opts MetricEventListOptions
+	if err := opts.SetNextPage("https://example.zendesk.com/api/v2/incremental/ticket_metric_events.json?start_time=1586505600"); err != nil {
+		t.Fatalf("Failed to set next page: %s", err)
+	}
+	if opts.StartTime != 1586505600 {
+		t.Fatalf("start time expect 1586505600, but got %d", opts.StartTime)
+	}
+
+	if err := opts.SetNextPage("https://example.zendesk.com/api/v2/incremental/ticket_metric_events.json"); err == nil {
+		t.Fatal("expected an error for a next page without start_time")
+	}
+}
+
+func TestGetIncrementalTicketMetricEventsEndOfStream(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ticket_metric_events":[],"next_page":"https://example.zendesk.com/next","count":0,"end_of_stream":false}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, _, eos, err := client.GetIncrementalTicketMetricEvents(ctx, &MetricEventListOptions{StartTime: 1586400000})
+	if err != nil {
+		t.Fatalf("Failed to get ticket metric events: %s", err)
+	}
+	if eos {
+		t.Fatal("expected end_of_stream of the payload to be used over the page size")
+	}
+}
+
+// weekdaySchedule opens from 9:00 to 17:00 UTC on Monday to Friday
+func weekdaySchedule() Schedule {
+	var intervals []ScheduleInterval
+	for day := 1; day <= 5; day++ {
+		intervals = append(intervals, ScheduleInterval{
+			StartTime: day*minutesPerDay + 9*60,
+			EndTime:   day*minutesPerDay + 17*60,
+		})
+	}
+	return Schedule{TimeZone: "UTC", Intervals: intervals}
+}
+
+func TestTimeToBreachAcrossWeekend(t *testing.T) {
+	// Friday 16:00 with 2 business hours, so it breaches on Monday 10:00
+	metric := MetricEvent{
+		Type: "apply_sla",
+		Time: time.Date(2021, 1, 8, 16, 0, 0, 0, time.UTC),
+		SLA:  &MetricEventSLA{Target: 120, BusinessHours: true},
+	}
+
+	cases := []struct {
+		now      time.Time
+		expected time.Duration
+	}{
+		{time.Date(2021, 1, 8, 16, 30, 0, 0, time.UTC), 90 * time.Minute},
+		{time.Date(2021, 1, 9, 12, 0, 0, 0, time.UTC), 60 * time.Minute},
+		{time.Date(2021, 1, 11, 9, 45, 0, 0, time.UTC), 15 * time.Minute},
+		{time.Date(2021, 1, 11, 10, 30, 0, 0, time.UTC), -30 * time.Minute},
+	}
+
+	for _, c := range cases {
+		left, ok := TimeToBreach(metric, weekdaySchedule(), c.now)
+		if !ok {
+			t.Fatalf("expected time to breach at %s", c.now)
+		}
+		if left != c.expected {
+			t.Fatalf("time to breach at %s expect %s, but got %s", c.now, c.expected, left)
+		}
+	}
+}
+
+func TestTimeToBreachSkipsHolidays(t *testing.T) {
+	metric := MetricEvent{
+		Type: "apply_sla",
+		Time: time.Date(2021, 1, 8, 16, 0, 0, 0, time.UTC),
+		SLA:  &MetricEventSLA{Target: 120, BusinessHours: true},
+	}
+	schedule := weekdaySchedule()
+	schedule.Holidays = []Holiday{{Name: "Day off", StartDate: "2021-01-11", EndDate: "2021-01-11"}}
+
+	// Monday is off, so it breaches on Tuesday 10:00
+	now := time.Date(2021, 1, 11, 12, 0, 0, 0, time.UTC)
+	left, ok := TimeToBreach(metric, schedule, now)
+	if !ok || left != 60*time.Minute {
+		t.Fatalf("time to breach expect 1h0m0s, but got %s (%v)", left, ok)
+	}
+}
+
+func TestTimeToBreachCalendarHours(t *testing.T) {
+	metric := MetricEvent{
+		Type: "apply_sla",
+		Time: time.Date(2021, 1, 8, 16, 0, 0, 0, time.UTC),
+		SLA:  &MetricEventSLA{Target: 120},
+	}
+
+	now := time.Date(2021, 1, 8, 19, 0, 0, 0, time.UTC)
+	left, ok := TimeToBreach(metric, Schedule{}, now)
+	if !ok || left != -time.Hour {
+		t.Fatalf("time to breach expect -1h0m0s, but got %s (%v)", left, ok)
+	}
+}
+
+func TestTimeToBreachWithoutSLA(t *testing.T) {
+	metric := MetricEvent{Type: "activate", Time: time.Date(2021, 1, 8, 16, 0, 0, 0, time.UTC)}
+	if _, ok := TimeToBreach(metric, weekdaySchedule(), metric.Time); ok {
+		t.Fatal("expected no time to breach for an event without SLA")
+	}
+
+	metric = MetricEvent{Type: "apply_sla", Time: metric.Time, SLA: &MetricEventSLA{Target: 60, BusinessHours: true}}
+	if _, ok := TimeToBreach(metric, Schedule{}, metric.Time); ok {
+		t.Fatal("expected no time to breach for a schedule without business hours")
+	}
+}
@@ -0,0 +1,90 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetTicketWithMetrics(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("include") != "metric_sets" {
+			t.Fatalf("Unexpected include param %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "ticket_metric_sets.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, metric, err := client.GetTicketWithMetrics(ctx, 4)
+	if err != nil {
+		t.Fatalf("Failed to get ticket with metrics: %s", err)
+	}
+
+	if ticket.ID != 4 {
+		t.Fatalf("Returned ticket does not have the expected ID 4. Ticket id is %d", ticket.ID)
+	}
+	if metric.TicketID != 4 || metric.Replies != 2 {
+		t.Fatalf("Metric was not parsed as expected: %v", metric)
+	}
+	if metric.ReplyTimeInMinutes.Business != 8 {
+		t.Fatalf("reply time in business minutes expect 8, but got %d", metric.ReplyTimeInMinutes.Business)
+	}
+	if metric.SolvedAt != nil {
+		t.Fatalf("solved_at expect nil, but got %v", metric.SolvedAt)
+	}
+}
+
+func TestGetTicketWithMetricsNotFound(t *testing.T) {
+	responses := []string{
+		`{"ticket":{"id":4}}`,
+		`{"ticket":{"id":4},"metric_sets":[]}`,
+		`{"ticket":{"id":4},"metric_sets":[{"id":1,"ticket_id":5}]}`,
+	}
+	for _, res := range responses {
+		mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(res))
+		}))
+		client := newTestClient(mockAPI)
+
+		ticket, _, err := client.GetTicketWithMetrics(ctx, 4)
+		mockAPI.Close()
+		if err != ErrTicketMetricNotFound {
+			t.Fatalf("expected ErrTicketMetricNotFound for %s, but got %v", res, err)
+		}
+		if ticket.ID != 4 {
+			t.Fatalf("Returned ticket does not have the expected ID 4. Ticket id is %d", ticket.ID)
+		}
+	}
+}
+
+func TestGetTicketsWithMetrics(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tickets.json" || r.URL.Query().Get("include") != "metric_sets" {
+			t.Fatalf("Unexpected request %s?%s", r.URL.Path, r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "tickets_metric_sets.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var metrics map[int64]TicketMetric
+	tickets, _, err := client.GetTickets(ctx, nil, IncludeTicketMetrics(&metrics))
+	if err != nil {
+		t.Fatalf("Failed to get tickets with metrics: %s", err)
+	}
+
+	if len(tickets) != 2 || len(metrics) != 2 {
+		t.Fatalf("expected 2 tickets and 2 metrics, but got %d and %d", len(tickets), len(metrics))
+	}
+	for _, ticket := range tickets {
+		metric, ok := metrics[ticket.ID]
+		if !ok || metric.TicketID != ticket.ID {
+			t.Fatalf("ticket %d does not have its metric: %v", ticket.ID, metric)
+		}
+	}
+	if metrics[3].Replies != 4 || metrics[2].ReplyTimeInMinutes.Business != 8 {
+		t.Fatalf("metrics were not associated with the expected tickets: %v", metrics)
+	}
+}
@@ -3,11 +3,19 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
+	"reflect"
 	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/tylerconlee/zendesk-go/zendesk/sideload"
 )
@@ -35,6 +43,293 @@ func TestGetTickets(t *testing.T) {
 	}
 }
 
+func TestGetTicketsSideloaded(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		expectedQuery := `users,organizations`
+		actual := r.URL.Query().Get("include")
+		if actual != expectedQuery {
+			t.Fatalf(`Actual query did not match expected. Was "%s" expected "%s"`, actual, expectedQuery)
+		}
+		w.Write([]byte(`{"tickets":[{"id":1},{"id":2}],"users":[],"organizations":[{"id":361898904439,"name":"nyan"}],"next_page":null,"previous_page":null,"count":2}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var orgs []Organization
+	tickets, _, err := client.GetTickets(ctx, &TicketListOptions{Sideload: "users"}, sideload.Organizations(&orgs))
+	if err != nil {
+		t.Fatalf("Failed to get tickets: %s", err)
+	}
+
+	if len(tickets) != 2 {
+		t.Fatalf("expected length of tickets is 2, but got %d", len(tickets))
+	}
+	if len(orgs) != 1 || orgs[0].ID != 361898904439 {
+		t.Fatalf("Organizations were not sideloaded as expected: %v", orgs)
+	}
+}
+
+func TestGetIncrementalTicketsSideloaded(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incremental/tickets.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("include") != "users" {
+			t.Fatalf("Unexpected include param %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"tickets":[{"id":1}],"users":[{"id":10}],"next_page":null,"end_time":1609459200,"end_of_stream":true}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var users []User
+	_, _, _, err := client.GetIncrementalTickets(ctx, &TicketListOptions{StartTime: "0"}, sideload.Users(&users))
+	if err != nil {
+		t.Fatalf("Failed to get incremental tickets: %s", err)
+	}
+
+	if len(users) != 1 || users[0].ID != 10 {
+		t.Fatalf("Users were not sideloaded as expected: %v", users)
+	}
+}
+
+func TestGetIncrementalTicketsCursor(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incremental/tickets/cursor.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("cursor") != "abc" {
+			t.Fatalf("Unexpected cursor param %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"tickets":[{"id":1}],"after_url":"https://example.zendesk.com/api/v2/incremental/tickets/cursor.json?cursor=def","after_cursor":"def","end_of_stream":false}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, afterURL, eos, err := client.GetIncrementalTicketsCursor(ctx, &TicketListOptions{Cursor: "abc"})
+	if err != nil {
+		t.Fatalf("Failed to get incremental tickets: %s", err)
+	}
+
+	if len(tickets) != 1 || tickets[0].ID != 1 {
+		t.Fatalf("Unexpected tickets %v", tickets)
+	}
+	if afterURL != "https://example.zendesk.com/api/v2/incremental/tickets/cursor.json?cursor=def" {
+		t.Fatalf("Unexpected after_url %s", afterURL)
+	}
+	if eos {
+		t.Fatal("expected the export not to reach the end of stream")
+	}
+}
+
+func TestGetIncrementalTicketsPerPage(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("per_page") != "1000" {
+			t.Fatalf("Unexpected per_page param %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"tickets":[],"after_url":null,"after_cursor":null,"end_of_stream":true}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	opts := &TicketListOptions{PageOptions: PageOptions{PerPage: incrementalExportLimit}, StartTime: "0"}
+	if _, _, _, err := client.GetIncrementalTickets(ctx, opts); err != nil {
+		t.Fatalf("Failed to get incremental tickets: %s", err)
+	}
+
+	opts.PerPage = incrementalExportLimit + 1
+	if _, _, _, err := client.GetIncrementalTickets(ctx, opts); err == nil {
+		t.Fatal("expect error for per_page over the incremental export limit")
+	}
+}
+
+func TestGetTicketsForUserAndOrganization(t *testing.T) {
+	cases := []struct {
+		name string
+		path string
+		fn   func(*Client, context.Context, int64, *TicketListOptions) ([]Ticket, Page, error)
+	}{
+		{"requested", "/users/123/tickets/requested.json", (*Client).GetTicketsRequestedByUser},
+		{"assigned", "/users/123/tickets/assigned.json", (*Client).GetTicketsAssignedToUser},
+		{"ccd", "/users/123/tickets/ccd.json", (*Client).GetTicketsCCdUser},
+		{"organization", "/organizations/123/tickets.json", (*Client).GetTicketsForOrganization},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != c.path {
+					t.Fatalf("path expect %s, but got %s", c.path, r.URL.Path)
+				}
+				if r.URL.Query().Get("page") != "2" {
+					t.Fatalf("Request does not have expected page param: %s", r.URL.RawQuery)
+				}
+				w.Write(readFixture(filepath.Join(http.MethodGet, "tickets.json")))
+			}))
+			defer mockAPI.Close()
+			client := newTestClient(mockAPI)
+
+			tickets, _, err := c.fn(client, ctx, 123, &TicketListOptions{
+				PageOptions: PageOptions{Page: 2},
+			})
+			if err != nil {
+				t.Fatalf("Failed to get tickets: %s", err)
+			}
+			if len(tickets) != 2 {
+				t.Fatalf("expected length of tickets is 2, but got %d", len(tickets))
+			}
+		})
+	}
+}
+
+func TestGetTicketCountForUserAndGroup(t *testing.T) {
+	cases := []struct {
+		name  string
+		query string
+		fn    func(*Client, context.Context, int64) (int64, error)
+	}{
+		{"user", "type:ticket status<solved assignee:123", (*Client).GetTicketCountForUser},
+		{"group", "type:ticket status<solved group:123", (*Client).GetTicketCountForGroup},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if q := r.URL.Query().Get("query"); q != c.query {
+					t.Fatalf("query expect %s, but got %s", c.query, q)
+				}
+				w.Write(readFixture(filepath.Join(http.MethodGet, "search_count.json")))
+			}))
+			defer mockAPI.Close()
+			client := newTestClient(mockAPI)
+
+			count, err := c.fn(client, ctx, 123)
+			if err != nil {
+				t.Fatalf("Failed to get ticket count: %s", err)
+			}
+			if count != 12 {
+				t.Fatalf("count expect 12, but got %d", count)
+			}
+		})
+	}
+}
+
+func TestGetTicketsByExternalID(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("external_id") != "ext-1" {
+			t.Fatalf(`external_id expect "ext-1", but got "%s"`, q.Get("external_id"))
+		}
+		if q.Get("per_page") != "10" {
+			t.Fatalf("Request does not have expected per_page param: %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "tickets.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, _, err := client.GetTicketsByExternalID(ctx, "ext-1", &PageOptions{PerPage: 10})
+	if err != nil {
+		t.Fatalf("Failed to get tickets: %s", err)
+	}
+	if len(tickets) != 2 {
+		t.Fatalf("expected length of tickets is 2, but got %d", len(tickets))
+	}
+}
+
+func newMultiPageTicketsMockAPI(t *testing.T) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page, err := strconv.Atoi(r.URL.Query().Get("page"))
+		if err != nil {
+			t.Fatalf("Request does not have valid page param: %s", r.URL.RawQuery)
+		}
+
+		next := "null"
+		if page < 3 {
+			next = fmt.Sprintf(`"%s/tickets.json?page=%d"`, server.URL, page+1)
+		}
+		fmt.Fprintf(w, `{"tickets":[{"id":%d},{"id":%d}],"next_page":%s,"previous_page":null,"count":6}`, page*2-1, page*2, next)
+	}))
+	return server
+}
+
+func TestGetAllTickets(t *testing.T) {
+	mockAPI := newMultiPageTicketsMockAPI(t)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, err := client.GetAllTickets(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to get all tickets: %s", err)
+	}
+
+	if len(tickets) != 6 {
+		t.Fatalf("expected length of tickets is 6, but got %d", len(tickets))
+	}
+	for i, ticket := range tickets {
+		if ticket.ID != int64(i+1) {
+			t.Fatalf("ticket %d does not have the expected ID %d. Ticket id is %d", i, i+1, ticket.ID)
+		}
+	}
+}
+
+func TestGetAllTicketsMaxResults(t *testing.T) {
+	mockAPI := newMultiPageTicketsMockAPI(t)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, err := client.GetAllTickets(ctx, &TicketListOptions{MaxResults: 3})
+	if err != nil {
+		t.Fatalf("Failed to get all tickets: %s", err)
+	}
+
+	if len(tickets) != 3 {
+		t.Fatalf("expected length of tickets is 3, but got %d", len(tickets))
+	}
+}
+
+func TestGetAllTicketsCanceledContext(t *testing.T) {
+	mockAPI := newMultiPageTicketsMockAPI(t)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	canceled, cancelFunc := context.WithCancel(ctx)
+	cancelFunc()
+	if _, err := client.GetAllTickets(canceled, nil); err == nil {
+		t.Fatal("Did not get error when calling with cancelled context")
+	}
+}
+
+func TestGetAllTicketsIncremental(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incremental/tickets/cursor.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			fmt.Fprintf(w, `{"tickets":[{"id":1}],"after_url":"%s/incremental/tickets/cursor.json?cursor=abc","after_cursor":"abc","before_url":null,"before_cursor":null,"end_of_stream":false}`, server.URL)
+		case "abc":
+			fmt.Fprint(w, `{"tickets":[{"id":2}],"after_url":null,"after_cursor":null,"before_url":null,"before_cursor":null,"end_of_stream":true}`)
+		default:
+			t.Fatalf("Unexpected cursor %s", r.URL.Query().Get("cursor"))
+		}
+	}))
+	client := newTestClient(server)
+	defer server.Close()
+
+	tickets, err := client.GetAllTickets(ctx, &TicketListOptions{StartTime: "1332034771"})
+	if err != nil {
+		t.Fatalf("Failed to get all tickets: %s", err)
+	}
+
+	if len(tickets) != 2 || tickets[0].ID != 1 || tickets[1].ID != 2 {
+		t.Fatalf("Returned tickets are not expected: %v", tickets)
+	}
+}
+
 func TestGetTicket(t *testing.T) {
 	mockAPI := newMockAPI(http.MethodGet, "ticket.json")
 	client := newTestClient(mockAPI)
@@ -49,6 +344,10 @@ func TestGetTicket(t *testing.T) {
 	if ticket.ID != expectedID {
 		t.Fatalf("Returned ticket does not have the expected ID %d. Ticket id is %d", expectedID, ticket.ID)
 	}
+
+	if ticket.Via == nil || ticket.Via.Channel != "email" {
+		t.Fatalf("Returned ticket does not have the expected via channel email. Via is %v", ticket.Via)
+	}
 }
 
 func TestGetTicketCanceledContext(t *testing.T) {
@@ -119,6 +418,54 @@ func TestGetTicketSideloaded(t *testing.T) {
 	}
 }
 
+func TestGetTicketSideloadedObjects(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		expectedQuery := `users,groups`
+		actual := r.URL.Query().Get("include")
+		if actual != expectedQuery {
+			t.Fatalf(`Actual query did not match expected. Was "%s" expected "%s"`, actual, expectedQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "ticket_sideload.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var users []User
+	var groups []Group
+	_, err := client.GetTicket(ctx, 4, sideload.Users(&users), sideload.Groups(&groups))
+	if err != nil {
+		t.Fatalf("Failed to get ticket: %s", err)
+	}
+
+	if len(users) != 1 || users[0].ID != 377922500012 {
+		t.Fatalf("Users were not sideloaded as expected: %v", users)
+	}
+	if len(groups) != 1 || groups[0].ID != 360004077472 {
+		t.Fatalf("Groups were not sideloaded as expected: %v", groups)
+	}
+}
+
+func TestGetTicketSideloadedOrganizations(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("include") != "organizations" {
+			t.Fatalf("Unexpected include param %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"ticket":{"id":4},"organizations":[{"id":361898904439,"name":"nyan"}]}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var orgs []Organization
+	_, err := client.GetTicket(ctx, 4, sideload.Organizations(&orgs))
+	if err != nil {
+		t.Fatalf("Failed to get ticket: %s", err)
+	}
+
+	if len(orgs) != 1 || orgs[0].Name != "nyan" {
+		t.Fatalf("Organizations were not sideloaded as expected: %v", orgs)
+	}
+}
+
 func TestTicketSideloadReturnsErrorIfNotPassedPointer(t *testing.T) {
 	mockAPI := newMockAPI(http.MethodGet, "ticket_sideload.json")
 	client := newTestClient(mockAPI)
@@ -133,21 +480,104 @@ func TestTicketSideloadReturnsErrorIfNotPassedPointer(t *testing.T) {
 }
 
 // Test the CustomField unmarshalling fails on an invalid value.
-// In this case a float64 as CustomField.Value should cause an error.
+// In this case an array of numbers as CustomField.Value should cause an error.
 func TestGetTicketWithInvalidCustomField(t *testing.T) {
-	// Test with a number value.
-	invalidCustomFieldJson := `{ "id": 360005657120, "value": 123.456 }`
 	var customField CustomField
+
+	// Test with an array of numbers.
+	invalidCustomFieldJson := `{ "id": 360005657120, "value": [123, 456] }`
 	err := json.Unmarshal([]byte(invalidCustomFieldJson), &customField)
 	if err == nil {
-		t.Fatalf("Expected an error when parsing a custom field of type number.")
+		t.Fatalf("Expected an error when parsing a custom field of type [number, ...].")
 	}
+}
 
-	// Test with an array of numbers.
-	invalidCustomFieldJson = `{ "id": 360005657120, "value": [123, 456] }`
-	err = json.Unmarshal([]byte(invalidCustomFieldJson), &customField)
+func TestCustomFieldUnmarshalEdgeCases(t *testing.T) {
+	cases := []struct {
+		name  string
+		json  string
+		id    int64
+		value interface{}
+	}{
+		{"numeric id", `{"id":360005657120,"value":"nyan"}`, 360005657120, "nyan"},
+		{"string id", `{"id":"360005657120","value":"nyan"}`, 360005657120, "nyan"},
+		{"integer value", `{"id":1,"value":42}`, 1, int64(42)},
+		{"decimal value", `{"id":1,"value":123.456}`, 1, 123.456},
+		{"null value", `{"id":1,"value":null}`, 1, nil},
+		{"bool value", `{"id":1,"value":false}`, 1, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var cf CustomField
+			if err := json.Unmarshal([]byte(c.json), &cf); err != nil {
+				t.Fatalf("Failed to unmarshal custom field: %s", err)
+			}
+			if cf.ID != c.id {
+				t.Fatalf("id expect %d, but got %d", c.id, cf.ID)
+			}
+			if cf.Value != c.value {
+				t.Fatalf("value expect %v (%T), but got %v (%T)", c.value, c.value, cf.Value, cf.Value)
+			}
+		})
+	}
+}
+
+func TestCustomFieldUnmarshalMalformedID(t *testing.T) {
+	for _, s := range []string{
+		`{"value":"nyan"}`,
+		`{"id":null,"value":"nyan"}`,
+		`{"id":"abc","value":"nyan"}`,
+		`{"id":1.5,"value":"nyan"}`,
+		`{"id":true,"value":"nyan"}`,
+	} {
+		var cf CustomField
+		if err := json.Unmarshal([]byte(s), &cf); err == nil {
+			t.Fatalf("Expected an error when parsing a custom field %s", s)
+		}
+	}
+}
+
+func TestCustomFieldMarshalRoundTrip(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{"string", "nyan", `{"id":1,"value":"nyan"}`},
+		{"string list", []string{"a", "b"}, `{"id":1,"value":["a","b"]}`},
+		{"empty string list", []string{}, `{"id":1,"value":[]}`},
+		{"bool", false, `{"id":1,"value":false}`},
+		{"integer", int64(42), `{"id":1,"value":42}`},
+		{"decimal", 1.5, `{"id":1,"value":1.5}`},
+		{"null", nil, `{"id":1,"value":null}`},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			b, err := json.Marshal(CustomField{ID: 1, Value: c.value})
+			if err != nil {
+				t.Fatalf("Failed to marshal custom field: %s", err)
+			}
+			if string(b) != c.expected {
+				t.Fatalf("json expect %s, but got %s", c.expected, string(b))
+			}
+
+			var cf CustomField
+			if err := json.Unmarshal(b, &cf); err != nil {
+				t.Fatalf("Failed to unmarshal custom field: %s", err)
+			}
+			if fmt.Sprintf("%#v", cf.Value) != fmt.Sprintf("%#v", c.value) {
+				t.Fatalf("value did not round-trip. expect %#v, but got %#v", c.value, cf.Value)
+			}
+		})
+	}
+}
+
+func TestCustomFieldMarshalInvalidValue(t *testing.T) {
+	_, err := json.Marshal(CustomField{ID: 1, Value: map[string]string{}})
 	if err == nil {
-		t.Fatalf("Expected an error when parsing a custom field of type [number, ...].")
+		t.Fatal("Expected an error when marshaling a custom field of type map")
 	}
 }
 
@@ -216,6 +646,116 @@ func TestGetMultipleTicket(t *testing.T) {
 	}
 }
 
+func TestGetMultipleTicketsWithSideload(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tickets/show_many.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		if include := r.URL.Query().Get("include"); include != "users,groups" {
+			t.Fatalf("include expect users,groups, but got %s", include)
+		}
+		w.Write([]byte(`{
+			"tickets":[{"id":2,"requester_id":10,"group_id":20},{"id":3,"requester_id":11,"group_id":20}],
+			"users":[{"id":10,"name":"Requester A"},{"id":11,"name":"Requester B"}],
+			"groups":[{"id":20,"name":"Support"}]
+		}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var users []User
+	var groups []Group
+	tickets, err := client.GetMultipleTickets(ctx, []int64{2, 3}, sideload.Users(&users), sideload.Groups(&groups))
+	if err != nil {
+		t.Fatalf("Failed to get tickets: %s", err)
+	}
+
+	if len(tickets) != 2 {
+		t.Fatalf("expected length of tickets is 2, but got %d", len(tickets))
+	}
+	if len(users) != 2 || users[1].Name != "Requester B" {
+		t.Fatalf("sideloaded users were not populated: %v", users)
+	}
+	if len(groups) != 1 || groups[0].Name != "Support" {
+		t.Fatalf("sideloaded groups were not populated: %v", groups)
+	}
+}
+
+func TestGetMultipleTicketsSideloadLimit(t *testing.T) {
+	mockAPI := httptest.NewServer(http.NotFoundHandler())
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var users []User
+	if _, err := client.GetMultipleTickets(ctx, make([]int64, manyLimit+1), sideload.Users(&users)); err == nil {
+		t.Fatal("GetMultipleTickets should fail to sideload more than 100 tickets")
+	}
+}
+
+func TestGetMultipleTicketsChunked(t *testing.T) {
+	var requests int32
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		if len(ids) > 100 {
+			t.Errorf("Request has more than 100 ids: %d", len(ids))
+		}
+
+		tickets := make([]string, len(ids))
+		for i, id := range ids {
+			tickets[i] = fmt.Sprintf(`{"id":%s}`, id)
+		}
+		fmt.Fprintf(w, `{"tickets":[%s]}`, strings.Join(tickets, ","))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ids := make([]int64, 250)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	tickets, err := client.GetMultipleTickets(ctx, ids)
+	if err != nil {
+		t.Fatalf("Failed to get tickets: %s", err)
+	}
+
+	if n := atomic.LoadInt32(&requests); n != 3 {
+		t.Fatalf("expected number of requests is 3, but got %d", n)
+	}
+	if len(tickets) != len(ids) {
+		t.Fatalf("expected length of tickets is %d, but got %d", len(ids), len(tickets))
+	}
+	for i, ticket := range tickets {
+		if ticket.ID != ids[i] {
+			t.Fatalf("Tickets are not in order. tickets[%d] has id %d", i, ticket.ID)
+		}
+	}
+}
+
+func TestGetMultipleTicketsChunkedError(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Query().Get("ids"), "101,") {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"tickets":[]}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ids := make([]int64, 250)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	_, err := client.GetMultipleTickets(ctx, ids)
+	if err == nil {
+		t.Fatal("GetMultipleTickets should return the error of a failed chunk")
+	}
+}
+
 func TestCreateTicket(t *testing.T) {
 	mockAPI := newMockAPIWithStatus(http.MethodPost, "ticket.json", http.StatusCreated)
 	client := newTestClient(mockAPI)
@@ -236,3 +776,529 @@ func TestCreateTicket(t *testing.T) {
 		t.Fatalf("Returned ticket does not have the expected ID %d. Ticket id is %d", expectedID, ticket.ID)
 	}
 }
+
+func TestCreateTicketWithUploads(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Ticket struct {
+				Comment struct {
+					Uploads []string `json:"uploads"`
+				} `json:"comment"`
+			} `json:"ticket"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode request body: %s", err)
+		}
+		expected := []string{"6bk3gql82em5nmf", "u5h3bx7kgq1w0pe"}
+		if !reflect.DeepEqual(data.Ticket.Comment.Uploads, expected) {
+			t.Fatalf("uploads expect %v, but got %v", expected, data.Ticket.Comment.Uploads)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateTicket(ctx, Ticket{
+		Subject: "Screenshots of the error",
+		Comment: TicketComment{
+			Body:    "See the attached screenshots",
+			Uploads: []string{"6bk3gql82em5nmf", "u5h3bx7kgq1w0pe"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create ticket: %s", err)
+	}
+}
+
+func TestCreateTicketWithNewRequester(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Ticket struct {
+				Requester map[string]interface{} `json:"requester"`
+			} `json:"ticket"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode request body: %s", err)
+		}
+		expected := map[string]interface{}{"name": "Jane Customer", "email": "jane@example.com", "locale_id": float64(8)}
+		if !reflect.DeepEqual(data.Ticket.Requester, expected) {
+			t.Fatalf("requester expect %v, but got %v", expected, data.Ticket.Requester)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, err := client.CreateTicket(ctx, Ticket{
+		Subject:   "My printer is on fire",
+		Comment:   TicketComment{Body: "Please help"},
+		Requester: &Requester{Name: "Jane Customer", Email: "jane@example.com", LocaleID: 8},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create ticket: %s", err)
+	}
+
+	if ticket.RequesterID != 377922500012 {
+		t.Fatalf("Returned ticket does not have the expected requester_id 377922500012. requester_id is %d", ticket.RequesterID)
+	}
+}
+
+func TestCreateFollowupTicket(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data struct {
+			Ticket map[string]interface{} `json:"ticket"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode request body: %s", err)
+		}
+		if id, _ := data.Ticket["via_followup_source_id"].(float64); id != 35436 {
+			t.Fatalf("via_followup_source_id expect 35436, but got %v", data.Ticket["via_followup_source_id"])
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.CreateFollowupTicket(ctx, 35436, Ticket{
+		Subject: "Printer is on fire again",
+		Comment: TicketComment{
+			Body: "Following up on the closed ticket",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create follow-up ticket: %s", err)
+	}
+}
+
+func TestUpdateTicket(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/tickets/2.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var data struct {
+			Ticket struct {
+				EmailCCs  []EmailCC  `json:"email_ccs"`
+				Followers []Follower `json:"followers"`
+			} `json:"ticket"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode request body: %s", err)
+		}
+		if len(data.Ticket.EmailCCs) != 1 || data.Ticket.EmailCCs[0].UserEmail != "cc@example.com" || data.Ticket.EmailCCs[0].Action != "put" {
+			t.Fatalf("Unexpected email_ccs: %v", data.Ticket.EmailCCs)
+		}
+		if len(data.Ticket.Followers) != 1 || data.Ticket.Followers[0].UserID != 123 || data.Ticket.Followers[0].Action != "delete" {
+			t.Fatalf("Unexpected followers: %v", data.Ticket.Followers)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ticket, err := client.UpdateTicket(ctx, 2, TicketUpdate{
+		EmailCCs:  []EmailCC{{UserEmail: "cc@example.com", Action: MutationActionPut}},
+		Followers: []Follower{{UserID: 123, Action: MutationActionDelete}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to update ticket: %s", err)
+	}
+
+	expectedID := int64(2)
+	if ticket.ID != expectedID {
+		t.Fatalf("Returned ticket does not have the expected ID %d. Ticket id is %d", expectedID, ticket.ID)
+	}
+}
+
+func TestAddAndRemoveTicketEmailCC(t *testing.T) {
+	var body string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+		w.Write(readFixture(filepath.Join(http.MethodPut, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.AddTicketEmailCC(ctx, 2, EmailCC{UserID: 123}, EmailCC{UserEmail: "cc@example.com"})
+	if err != nil {
+		t.Fatalf("Failed to add email CC: %s", err)
+	}
+	expected := `{"ticket":{"email_ccs":[{"user_id":123,"action":"put"},{"user_email":"cc@example.com","action":"put"}]}}`
+	if body != expected {
+		t.Fatalf("request body expect %s, but got %s", expected, body)
+	}
+
+	err = client.RemoveTicketEmailCC(ctx, 2, EmailCC{UserID: 123})
+	if err != nil {
+		t.Fatalf("Failed to remove email CC: %s", err)
+	}
+	expected = `{"ticket":{"email_ccs":[{"user_id":123,"action":"delete"}]}}`
+	if body != expected {
+		t.Fatalf("request body expect %s, but got %s", expected, body)
+	}
+}
+
+func TestCreateManyTickets(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/tickets/create_many.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var data struct {
+			Tickets []Ticket `json:"tickets"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+			t.Fatalf("Failed to decode request body: %s", err)
+		}
+		if len(data.Tickets) != 2 || data.Tickets[1].Subject != "second" {
+			t.Fatalf("Unexpected bulk envelope: %v", data.Tickets)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPost, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	jobs, err := client.CreateManyTickets(ctx, []Ticket{
+		{Subject: "first", Comment: TicketComment{Body: "nyan"}},
+		{Subject: "second", Comment: TicketComment{Body: "nyan"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create many tickets: %s", err)
+	}
+
+	if len(jobs) != 1 || jobs[0].ID != "8b726e606741012ffc2d782bcb7848fe" || jobs[0].Status != "queued" {
+		t.Fatalf("Job status was not parsed as expected: %v", jobs)
+	}
+}
+
+func TestCreateManyTicketsValidation(t *testing.T) {
+	client, _ := NewClient(nil)
+
+	if _, err := client.CreateManyTickets(ctx, nil); err == nil {
+		t.Fatal("CreateManyTickets should fail with no tickets")
+	}
+
+	if _, err := client.CreateManyTickets(ctx, []Ticket{{Subject: "no comment"}}); err == nil {
+		t.Fatal("CreateManyTickets should fail with a ticket without comment")
+	}
+}
+
+func newBulkMockAPI(t *testing.T, batches *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(batches, 1)
+
+		n := len(strings.Split(r.URL.Query().Get("ids"), ","))
+		if r.Method != http.MethodDelete {
+			var data struct {
+				Tickets []Ticket `json:"tickets"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+				t.Fatalf("Failed to decode request body: %s", err)
+			}
+			n = len(data.Tickets)
+		}
+		if n > 100 {
+			t.Errorf("Batch has more than 100 items: %d", n)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPost, "job_status.json")))
+	}))
+}
+
+func TestBulkTicketOperationsAreChunked(t *testing.T) {
+	tickets := make([]Ticket, 201)
+	ids := make([]int64, 201)
+	for i := range tickets {
+		ids[i] = int64(i + 1)
+		tickets[i] = Ticket{ID: ids[i], Comment: TicketComment{Body: "nyan"}}
+	}
+
+	cases := []struct {
+		name string
+		fn   func(c *Client) ([]JobStatus, error)
+	}{
+		{"create", func(c *Client) ([]JobStatus, error) { return c.CreateManyTickets(ctx, tickets) }},
+		{"update", func(c *Client) ([]JobStatus, error) { return c.UpdateManyTickets(ctx, tickets) }},
+		{"delete", func(c *Client) ([]JobStatus, error) { return c.DeleteManyTickets(ctx, ids) }},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var batches int32
+			mockAPI := newBulkMockAPI(t, &batches)
+			client := newTestClient(mockAPI)
+			defer mockAPI.Close()
+
+			jobs, err := c.fn(client)
+			if err != nil {
+				t.Fatalf("Failed to run bulk operation: %s", err)
+			}
+			if n := atomic.LoadInt32(&batches); n != 3 {
+				t.Fatalf("expected number of batches is 3, but got %d", n)
+			}
+			if len(jobs) != 3 {
+				t.Fatalf("expected length of job statuses is 3, but got %d", len(jobs))
+			}
+		})
+	}
+}
+
+func TestTicketMarshalDropsReadOnlyFields(t *testing.T) {
+	var fetched struct {
+		Ticket Ticket `json:"ticket"`
+	}
+	if err := json.Unmarshal(readFixture(filepath.Join(http.MethodGet, "ticket.json")), &fetched); err != nil {
+		t.Fatalf("Failed to unmarshal ticket: %s", err)
+	}
+
+	ticket := fetched.Ticket
+	ticket.Subject = "modified"
+	ticket.Comment = TicketComment{Body: "nyan"}
+
+	b, err := json.Marshal(ticketPayload(ticket))
+	if err != nil {
+		t.Fatalf("Failed to marshal ticket: %s", err)
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		t.Fatalf("Failed to unmarshal marshaled ticket: %s", err)
+	}
+
+	for _, key := range []string{"url", "created_at", "updated_at", "satisfaction_rating", "slas", "metric_events", "collaborators"} {
+		if _, ok := fields[key]; ok {
+			t.Fatalf("Marshaled ticket should not contain read-only key %s: %s", key, string(b))
+		}
+	}
+	if fields["subject"] != "modified" {
+		t.Fatalf("subject expect modified, but got %v", fields["subject"])
+	}
+	if comment, ok := fields["comment"].(map[string]interface{}); !ok || comment["body"] != "nyan" {
+		t.Fatalf("comment was not marshaled as expected: %v", fields["comment"])
+	}
+}
+
+func TestTicketMarshalRoundTrip(t *testing.T) {
+	var fetched struct {
+		Ticket Ticket `json:"ticket"`
+	}
+	if err := json.Unmarshal(readFixture(filepath.Join(http.MethodGet, "ticket.json")), &fetched); err != nil {
+		t.Fatalf("Failed to unmarshal ticket: %s", err)
+	}
+
+	b, err := json.Marshal(fetched.Ticket)
+	if err != nil {
+		t.Fatalf("Failed to marshal ticket: %s", err)
+	}
+
+	var ticket Ticket
+	if err := json.Unmarshal(b, &ticket); err != nil {
+		t.Fatalf("Failed to unmarshal marshaled ticket: %s", err)
+	}
+	again, err := json.Marshal(ticket)
+	if err != nil {
+		t.Fatalf("Failed to marshal ticket: %s", err)
+	}
+	if string(again) != string(b) {
+		t.Fatalf("ticket changed in round trip\nExpect:\t%s\nGot:\t%s", b, again)
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		t.Fatalf("Failed to unmarshal marshaled ticket: %s", err)
+	}
+	for _, key := range []string{"url", "description", "via", "satisfaction_rating", "is_public", "created_at", "updated_at", "slas", "metric_events"} {
+		if _, ok := fields[key]; !ok {
+			t.Fatalf("Marshaled ticket should contain read-only key %s: %s", key, string(b))
+		}
+	}
+}
+
+func TestTicketMarshalKeepsCollaborators(t *testing.T) {
+	var ticket Ticket
+	ticket.Collaborators.Append("someone@example.com")
+
+	b, err := json.Marshal(ticketPayload(ticket))
+	if err != nil {
+		t.Fatalf("Failed to marshal ticket: %s", err)
+	}
+
+	expected := `{"collaborators":["someone@example.com"]}`
+	if string(b) != expected {
+		t.Fatalf("json expect %s, but got %s", expected, string(b))
+	}
+}
+
+func TestAddTagsToManyTickets(t *testing.T) {
+	var mu sync.Mutex
+	var batches [][]string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/tickets/update_many.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if expected := `{"ticket":{"additional_tags":["renamed","product-b"]}}`; string(body) != expected {
+			t.Fatalf("request body expect %s, but got %s", expected, string(body))
+		}
+
+		mu.Lock()
+		batches = append(batches, strings.Split(r.URL.Query().Get("ids"), ","))
+		mu.Unlock()
+		w.Write(readFixture(filepath.Join(http.MethodPost, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ids := make([]int64, 250)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	jobs, err := client.AddTagsToManyTickets(ctx, ids, []string{"renamed", "product-b"})
+	if err != nil {
+		t.Fatalf("Failed to add tags to many tickets: %s", err)
+	}
+
+	if len(jobs) != 3 || len(batches) != 3 {
+		t.Fatalf("expected 3 job submissions, but got %d jobs and %d batches", len(jobs), len(batches))
+	}
+	for i, expected := range []int{100, 100, 50} {
+		if len(batches[i]) != expected {
+			t.Fatalf("expected length of batch %d is %d, but got %d", i, expected, len(batches[i]))
+		}
+	}
+	if batches[2][0] != "201" || batches[2][49] != "250" {
+		t.Fatalf("last batch has unexpected ids %v", batches[2])
+	}
+}
+
+func TestRemoveTagsFromManyTickets(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if expected := `{"ticket":{"remove_tags":["legacy"]}}`; string(body) != expected {
+			t.Fatalf("request body expect %s, but got %s", expected, string(body))
+		}
+		if ids := r.URL.Query().Get("ids"); ids != "1,2" {
+			t.Fatalf("ids expect 1,2, but got %s", ids)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPost, "job_status.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.RemoveTagsFromManyTickets(ctx, []int64{1, 2}, []string{"legacy"}); err != nil {
+		t.Fatalf("Failed to remove tags from many tickets: %s", err)
+	}
+
+	if _, err := client.RemoveTagsFromManyTickets(ctx, []int64{1, 2}, nil); err == nil {
+		t.Fatal("RemoveTagsFromManyTickets should fail with no tags")
+	}
+}
+
+func TestGetTicketsSince(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incremental/tickets/cursor.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		switch {
+		case q.Get("start_time") == "1609459200":
+			fmt.Fprintf(w, `{"tickets":[{"id":1,"updated_at":"2021-01-01T01:00:00Z"},{"id":2,"updated_at":"2021-01-01T02:00:00Z"}],"after_url":"%s/incremental/tickets/cursor.json?cursor=first","after_cursor":"first","before_url":null,"before_cursor":null,"end_of_stream":false}`, server.URL)
+		case q.Get("cursor") == "first":
+			// Ticket 1 was updated again during the export
+			fmt.Fprintf(w, `{"tickets":[{"id":3,"updated_at":"2021-01-01T03:00:00Z"},{"id":1,"updated_at":"2021-01-01T04:00:00Z"}],"after_url":"%s/incremental/tickets/cursor.json?cursor=second","after_cursor":"second","before_url":null,"before_cursor":null,"end_of_stream":false}`, server.URL)
+		case q.Get("cursor") == "second":
+			fmt.Fprint(w, `{"tickets":[{"id":4,"updated_at":"2021-01-01T05:00:00Z"}],"after_url":null,"after_cursor":null,"before_url":null,"before_cursor":null,"end_of_stream":true}`)
+		default:
+			t.Fatalf("Unexpected query %s", r.URL.RawQuery)
+		}
+	}))
+	client := newTestClient(server)
+	defer server.Close()
+
+	tickets, errs := client.GetTicketsSince(ctx, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	var ids []int64
+	for ticket := range tickets {
+		ids = append(ids, ticket.ID)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("Failed to get tickets since: %s", err)
+	}
+
+	expected := []int64{1, 2, 3, 1, 4}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Fatalf("expected tickets %v, but got %v", expected, ids)
+	}
+}
+
+func TestGetTicketsSinceError(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, errs := client.GetTicketsSince(ctx, time.Now())
+	for range tickets {
+		t.Fatal("expected no tickets")
+	}
+	if err := <-errs; err == nil {
+		t.Fatal("expected an error from the export")
+	}
+}
+
+func TestGetIncrementalTicketsRejectsSort(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("Unexpected request %s", r.URL.String())
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	opts := []*TicketListOptions{
+		{StartTime: "1609459200", SortBy: "updated_at"},
+		{StartTime: "1609459200", SortOrder: "desc"},
+	}
+	for _, o := range opts {
+		if _, _, _, err := client.GetIncrementalTickets(ctx, o); err != ErrIncrementalSort {
+			t.Fatalf("expected ErrIncrementalSort, but got %v", err)
+		}
+		if _, _, _, err := client.GetIncrementalTicketsCursor(ctx, o); err != ErrIncrementalSort {
+			t.Fatalf("expected ErrIncrementalSort from cursor export, but got %v", err)
+		}
+	}
+}
+
+func TestBulkOperationsRejectEmptyInput(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("Unexpected request %s %s", r.Method, r.URL.String())
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	cases := []struct {
+		name string
+		fn   func() error
+	}{
+		{"get tickets", func() error { _, err := client.GetMultipleTickets(ctx, nil); return err }},
+		{"create tickets", func() error { _, err := client.CreateManyTickets(ctx, nil); return err }},
+		{"update tickets", func() error { _, err := client.UpdateManyTickets(ctx, nil); return err }},
+		{"delete tickets", func() error { _, err := client.DeleteManyTickets(ctx, nil); return err }},
+		{"tag tickets", func() error { _, err := client.AddTagsToManyTickets(ctx, nil, []string{"nyan"}); return err }},
+		{"recover suspended tickets", func() error { _, err := client.RecoverManySuspendedTickets(ctx, nil); return err }},
+		{"count views", func() error { _, err := client.GetViewCountMany(ctx, nil); return err }},
+		{"reorder views", func() error { return client.ReorderViews(ctx, nil) }},
+		{"update views", func() error { _, err := client.UpdateManyViews(ctx, nil); return err }},
+		{"delete views", func() error { return client.DestroyManyViews(ctx, nil) }},
+	}
+
+	for _, c := range cases {
+		if err := c.fn(); err == nil {
+			t.Fatalf("%s: expected an error for empty input", c.name)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package zendesk
+
+import "time"
+
+// TicketUpdate is a payload of UpdateTicket.
+// Unlike Ticket, a nil field is left as is and a non-nil field is written
+// even if it points to a zero value, e.g. an empty string or 0.
+// AdditionalTags and RemoveTags add or remove tags without replacing the
+// whole list, so that tags set concurrently by others are kept
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/tickets#update-ticket
+type TicketUpdate struct {
+	ExternalID     *string        `json:"external_id,omitempty"`
+	Type           *string        `json:"type,omitempty"`
+	Subject        *string        `json:"subject,omitempty"`
+	Priority       *string        `json:"priority,omitempty"`
+	Status         *string        `json:"status,omitempty"`
+	CustomStatusID *int64         `json:"custom_status_id,omitempty"`
+	RequesterID    *int64         `json:"requester_id,omitempty"`
+	AssigneeID     *int64         `json:"assignee_id,omitempty"`
+	OrganizationID *int64         `json:"organization_id,omitempty"`
+	GroupID        *int64         `json:"group_id,omitempty"`
+	ProblemID      *int64         `json:"problem_id,omitempty"`
+	TicketFormID   *int64         `json:"ticket_form_id,omitempty"`
+	BrandID        *int64         `json:"brand_id,omitempty"`
+	DueAt          *time.Time     `json:"due_at,omitempty"`
+	Tags           *[]string      `json:"tags,omitempty"`
+	AdditionalTags []string       `json:"additional_tags,omitempty"`
+	RemoveTags     []string       `json:"remove_tags,omitempty"`
+	CustomFields   []CustomField  `json:"custom_fields,omitempty"`
+	Comment        *TicketComment `json:"comment,omitempty"`
+	EmailCCs       []EmailCC      `json:"email_ccs,omitempty"`
+	Followers      []Follower     `json:"followers,omitempty"`
+}
+
+// Int64 returns a pointer to v. It's useful to set fields of TicketUpdate and OrganizationUpdate
+func Int64(v int64) *int64 {
+	return &v
+}
+
+// String returns a pointer to v. It's useful to set fields of TicketUpdate and OrganizationUpdate
+func String(v string) *string {
+	return &v
+}
+
+// Bool returns a pointer to v. It's useful to set fields of OrganizationUpdate
+func Bool(v bool) *bool {
+	return &v
+}
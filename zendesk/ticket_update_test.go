@@ -0,0 +1,98 @@
+package zendesk
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTicketUpdateSendsOnlySetFields(t *testing.T) {
+	b, err := json.Marshal(TicketUpdate{
+		AssigneeID: Int64(0),
+		GroupID:    Int64(360004077472),
+		Subject:    String(""),
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal ticket update: %s", err)
+	}
+
+	expected := `{"subject":"","assignee_id":0,"group_id":360004077472}`
+	if string(b) != expected {
+		t.Fatalf("json expect %s, but got %s", expected, string(b))
+	}
+}
+
+func TestTicketUpdateIncrementalTags(t *testing.T) {
+	b, err := json.Marshal(TicketUpdate{
+		AdditionalTags: []string{"vip", "escalated"},
+		RemoveTags:     []string{"pending_review"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to marshal ticket update: %s", err)
+	}
+
+	expected := `{"additional_tags":["vip","escalated"],"remove_tags":["pending_review"]}`
+	if string(b) != expected {
+		t.Fatalf("json expect %s, but got %s", expected, string(b))
+	}
+}
+
+func TestUpdateTicketUnassign(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		expected := `{"ticket":{"assignee_id":0}}`
+		if string(body) != expected {
+			t.Fatalf("request body expect %s, but got %s", expected, string(body))
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if _, err := client.UpdateTicket(ctx, 2, TicketUpdate{AssigneeID: Int64(0)}); err != nil {
+		t.Fatalf("Failed to update ticket: %s", err)
+	}
+}
+
+func TestUpdateTicketSafe(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		expected := `{"ticket":{"status":"solved","safe_update":true,"updated_stamp":"2019-09-12T21:45:16Z"}}`
+		if string(body) != expected {
+			t.Fatalf("request body expect %s, but got %s", expected, string(body))
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "ticket.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	stamp := time.Date(2019, 9, 12, 21, 45, 16, 0, time.UTC)
+	if _, err := client.UpdateTicketSafe(ctx, 2, TicketUpdate{Status: String("solved")}, stamp); err != nil {
+		t.Fatalf("Failed to update ticket: %s", err)
+	}
+}
+
+func TestUpdateTicketSafeConflict(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"error":"UpdateConflict","description":"Safe Update prevented the update due to outdated ticket data. Please fetch the latest ticket data and try again."}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	stamp := time.Date(2019, 9, 12, 21, 45, 16, 0, time.UTC)
+	_, err := client.UpdateTicketSafe(ctx, 2, TicketUpdate{Status: String("solved")}, stamp)
+
+	var conflict *ConflictError
+	if !errors.As(err, &conflict) {
+		t.Fatalf("expected *ConflictError, but got %v", err)
+	}
+	if conflict.Err.Status() != http.StatusConflict {
+		t.Fatalf("expected status 409, but got %d", conflict.Err.Status())
+	}
+}
@@ -0,0 +1,66 @@
+package zendesk
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrTicketUnassigned is returned by GetTicketAssignee when the ticket has no assignee
+var ErrTicketUnassigned = errors.New("ticket is not assigned")
+
+// ErrTicketNoRequester is returned by GetTicketRequester when the ticket has no requester
+var ErrTicketNoRequester = errors.New("ticket has no requester")
+
+// TicketUserAPI an interface containing methods to resolve users of tickets
+type TicketUserAPI interface {
+	GetTicketRequester(ctx context.Context, ticket Ticket) (User, error)
+	GetTicketAssignee(ctx context.Context, ticket Ticket) (User, error)
+	ResolveTicketUsers(ctx context.Context, tickets []Ticket) (map[int64]User, error)
+}
+
+// GetTicketRequester get the requester of the ticket.
+// It returns ErrTicketNoRequester if the ticket has no requester
+func (z *Client) GetTicketRequester(ctx context.Context, ticket Ticket) (User, error) {
+	if ticket.RequesterID == 0 {
+		return User{}, ErrTicketNoRequester
+	}
+	return z.GetUser(ctx, ticket.RequesterID)
+}
+
+// GetTicketAssignee get the assignee of the ticket.
+// It returns ErrTicketUnassigned if the ticket has no assignee
+func (z *Client) GetTicketAssignee(ctx context.Context, ticket Ticket) (User, error) {
+	if ticket.AssigneeID == 0 {
+		return User{}, ErrTicketUnassigned
+	}
+	return z.GetUser(ctx, ticket.AssigneeID)
+}
+
+// ResolveTicketUsers get requesters, submitters and assignees of the tickets keyed by user id.
+// Ids are deduplicated across the tickets and fetched with GetManyUsers,
+// which takes one request per 100 users
+func (z *Client) ResolveTicketUsers(ctx context.Context, tickets []Ticket) (map[int64]User, error) {
+	seen := make(map[int64]bool)
+	var ids []int64
+	for _, t := range tickets {
+		for _, id := range []int64{t.RequesterID, t.SubmitterID, t.AssigneeID} {
+			if id == 0 || seen[id] {
+				continue
+			}
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	users := make(map[int64]User, len(ids))
+	for _, batch := range chunkIDs(ids, manyLimit) {
+		list, err := z.GetManyUsers(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range list {
+			users[u.ID] = u
+		}
+	}
+	return users, nil
+}
@@ -0,0 +1,81 @@
+package zendesk
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetTicketRequester(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/369531345753.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "user.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.GetTicketRequester(ctx, Ticket{RequesterID: 369531345753})
+	if err != nil {
+		t.Fatalf("Failed to get ticket requester: %s", err)
+	}
+
+	if user.ID != 369531345753 {
+		t.Fatalf("Returned user does not have the expected ID 369531345753. User id is %d", user.ID)
+	}
+}
+
+func TestGetTicketRequesterMissing(t *testing.T) {
+	client, _ := NewClient(nil)
+
+	if _, err := client.GetTicketRequester(ctx, Ticket{ID: 2}); err != ErrTicketNoRequester {
+		t.Fatalf("expected ErrTicketNoRequester, but got %v", err)
+	}
+}
+
+func TestGetTicketAssigneeUnassigned(t *testing.T) {
+	client, _ := NewClient(nil)
+
+	if _, err := client.GetTicketAssignee(ctx, Ticket{ID: 2}); err != ErrTicketUnassigned {
+		t.Fatalf("expected ErrTicketUnassigned, but got %v", err)
+	}
+}
+
+func TestResolveTicketUsers(t *testing.T) {
+	calls := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path != "/users/show_many.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		if ids := r.URL.Query().Get("ids"); ids != "369531345753,369537351454" {
+			t.Fatalf(`ids param expect "369531345753,369537351454", but got "%s"`, ids)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "users.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets := []Ticket{
+		{ID: 1, RequesterID: 369531345753, SubmitterID: 369531345753, AssigneeID: 369537351454},
+		{ID: 2, RequesterID: 369537351454, SubmitterID: 369537351454},
+		{ID: 3, RequesterID: 369531345753, SubmitterID: 369537351454, AssigneeID: 369537351454},
+	}
+
+	users, err := client.ResolveTicketUsers(ctx, tickets)
+	if err != nil {
+		t.Fatalf("Failed to resolve ticket users: %s", err)
+	}
+
+	if calls != 1 {
+		t.Fatalf("expected users to be fetched in 1 request, but got %d", calls)
+	}
+	if len(users) != 2 {
+		t.Fatalf("expected length of users is 2, but got %d", len(users))
+	}
+	if users[369537351454].ID != 369537351454 {
+		t.Fatalf("user 369537351454 was not resolved: %v", users)
+	}
+}
@@ -32,6 +32,7 @@ type Trigger struct {
 	Title      string `json:"title"`
 	Active     bool   `json:"active,omitempty"`
 	Position   int64  `json:"position,omitempty"`
+	CategoryID string `json:"category_id,omitempty"`
 	Conditions struct {
 		All []TriggerCondition `json:"all"`
 		Any []TriggerCondition `json:"any"`
@@ -0,0 +1,136 @@
+package zendesk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// TriggerCategory is a category which groups triggers.
+// Unlike most resources, its ID is a string
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/trigger_categories#json-format
+type TriggerCategory struct {
+	ID        string     `json:"id,omitempty"`
+	Name      string     `json:"name,omitempty"`
+	Position  int64      `json:"position,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// TriggerPosition is a position of a trigger and the category it belongs to for ReorderTriggers
+type TriggerPosition struct {
+	ID         int64  `json:"id,string"`
+	Position   int64  `json:"position"`
+	CategoryID string `json:"category_id,omitempty"`
+}
+
+// TriggerCategoryAPI an interface containing all trigger category related methods
+type TriggerCategoryAPI interface {
+	GetTriggerCategories(ctx context.Context) ([]TriggerCategory, error)
+	CreateTriggerCategory(ctx context.Context, category TriggerCategory) (TriggerCategory, error)
+	UpdateTriggerCategory(ctx context.Context, id string, category TriggerCategory) (TriggerCategory, error)
+	DeleteTriggerCategory(ctx context.Context, id string) error
+	ReorderTriggers(ctx context.Context, categories []TriggerCategory, triggers []TriggerPosition) error
+}
+
+// GetTriggerCategories fetch trigger category list
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/trigger_categories#list-trigger-categories
+func (z *Client) GetTriggerCategories(ctx context.Context) ([]TriggerCategory, error) {
+	var data struct {
+		TriggerCategories []TriggerCategory `json:"trigger_categories"`
+	}
+
+	body, err := z.get(ctx, "/trigger_categories.json")
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, err
+	}
+	return data.TriggerCategories, nil
+}
+
+// CreateTriggerCategory creates new trigger category
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/trigger_categories#create-trigger-category
+func (z *Client) CreateTriggerCategory(ctx context.Context, category TriggerCategory) (TriggerCategory, error) {
+	var data, result struct {
+		TriggerCategory TriggerCategory `json:"trigger_category"`
+	}
+	data.TriggerCategory = category
+
+	body, err := z.post(ctx, "/trigger_categories.json", data)
+	if err != nil {
+		return TriggerCategory{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TriggerCategory{}, err
+	}
+	return result.TriggerCategory, nil
+}
+
+// UpdateTriggerCategory updates the specified trigger category and returns the updated one
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/trigger_categories#update-trigger-category
+func (z *Client) UpdateTriggerCategory(ctx context.Context, id string, category TriggerCategory) (TriggerCategory, error) {
+	var data, result struct {
+		TriggerCategory TriggerCategory `json:"trigger_category"`
+	}
+	data.TriggerCategory = category
+
+	body, err := z.patch(ctx, fmt.Sprintf("/trigger_categories/%s.json", id), data)
+	if err != nil {
+		return TriggerCategory{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return TriggerCategory{}, err
+	}
+	return result.TriggerCategory, nil
+}
+
+// DeleteTriggerCategory deletes the specified trigger category.
+// Only a category without triggers can be deleted
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/trigger_categories#delete-trigger-category
+func (z *Client) DeleteTriggerCategory(ctx context.Context, id string) error {
+	return z.delete(ctx, fmt.Sprintf("/trigger_categories/%s.json", id))
+}
+
+// ReorderTriggers updates positions of trigger categories, and positions and
+// categories of triggers in a single batch
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/trigger_categories#create-batch-job-for-trigger-categories
+func (z *Client) ReorderTriggers(ctx context.Context, categories []TriggerCategory, triggers []TriggerPosition) error {
+	type categoryPosition struct {
+		ID       string `json:"id"`
+		Position int64  `json:"position"`
+	}
+
+	var data struct {
+		Job struct {
+			Action string `json:"action"`
+			Items  struct {
+				TriggerCategories []categoryPosition `json:"trigger_categories,omitempty"`
+				Triggers          []TriggerPosition  `json:"triggers,omitempty"`
+			} `json:"items"`
+		} `json:"job"`
+	}
+	data.Job.Action = "patch"
+	for _, c := range categories {
+		data.Job.Items.TriggerCategories = append(data.Job.Items.TriggerCategories, categoryPosition{ID: c.ID, Position: c.Position})
+	}
+	data.Job.Items.Triggers = triggers
+
+	_, err := z.send(ctx, http.MethodPost, "/trigger_categories/jobs.json", data, http.StatusOK)
+	return err
+}
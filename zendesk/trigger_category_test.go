@@ -0,0 +1,101 @@
+package zendesk
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetTriggerCategories(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "trigger_categories.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	categories, err := client.GetTriggerCategories(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get trigger categories: %s", err)
+	}
+
+	if len(categories) != 2 {
+		t.Fatalf("expected length of trigger categories is 2, but got %d", len(categories))
+	}
+	if c := categories[1]; c.ID != "10027" || c.Name != "Routing" || c.Position != 1 {
+		t.Fatalf("Trigger category was not parsed as expected: %v", c)
+	}
+}
+
+func TestCreateTriggerCategory(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "trigger_category.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	category, err := client.CreateTriggerCategory(ctx, TriggerCategory{Name: "Escalations", Position: 2})
+	if err != nil {
+		t.Fatalf("Failed to create trigger category: %s", err)
+	}
+
+	if category.ID != "10028" {
+		t.Fatalf("Returned trigger category does not have the expected ID 10028. Trigger category id is %s", category.ID)
+	}
+}
+
+func TestUpdateTriggerCategory(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/trigger_categories/10028.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPatch, "trigger_category.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	category, err := client.UpdateTriggerCategory(ctx, "10028", TriggerCategory{Name: "Urgent escalations"})
+	if err != nil {
+		t.Fatalf("Failed to update trigger category: %s", err)
+	}
+
+	if category.Name != "Urgent escalations" {
+		t.Fatalf("Returned trigger category does not have the expected name. Name is %s", category.Name)
+	}
+}
+
+func TestDeleteTriggerCategory(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.DeleteTriggerCategory(ctx, "10028"); err != nil {
+		t.Fatalf("Failed to delete trigger category: %s", err)
+	}
+}
+
+func TestReorderTriggers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/trigger_categories/jobs.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		expected := `{"job":{"action":"patch","items":{` +
+			`"trigger_categories":[{"id":"10027","position":0},{"id":"10026","position":1}],` +
+			`"triggers":[{"id":"360056295714","position":3,"category_id":"10027"}]}}}`
+		if string(body) != expected {
+			t.Fatalf("request body expect %s, but got %s", expected, string(body))
+		}
+		w.Write([]byte(`{"results":{"trigger_categories":[],"triggers":[]}}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.ReorderTriggers(ctx,
+		[]TriggerCategory{{ID: "10027", Position: 0}, {ID: "10026", Position: 1}},
+		[]TriggerPosition{{ID: 360056295714, Position: 3, CategoryID: "10027"}},
+	)
+	if err != nil {
+		t.Fatalf("Failed to reorder triggers: %s", err)
+	}
+}
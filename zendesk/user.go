@@ -4,7 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"time"
+
+	"github.com/tylerconlee/zendesk-go/zendesk/sideload"
 )
 
 // User is zendesk user JSON payload format
@@ -47,6 +50,9 @@ type User struct {
 	LastLoginAt time.Time `json:"last_login_at,omitempty"`
 	CreatedAt   time.Time `json:"created_at,omitempty"`
 	UpdatedAt   time.Time `json:"updated_at,omitempty"`
+
+	// Identities is filled by GetIncrementalUsers when identities are sideloaded
+	Identities []sideload.UserIdentity `json:"identities,omitempty"`
 }
 
 const (
@@ -74,6 +80,24 @@ type UserListOptions struct {
 	PermissionSet int64    `url:"permission_set,omitempty"`
 }
 
+// UserIncrementalOptions is options for GetIncrementalUsers.
+// Set StartTime for the first page and Cursor for the following ones
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/incremental_export#incremental-user-export
+type UserIncrementalOptions struct {
+	StartTime int64  `url:"start_time,omitempty"`
+	Cursor    string `url:"cursor,omitempty"`
+	PerPage   int    `url:"per_page,omitempty"`
+
+	// Sideload includes additional endpoints
+	Sideload string `url:"include,omitempty"`
+}
+
+// Validate checks PerPage is within the page limit of incremental export
+func (o UserIncrementalOptions) Validate() error {
+	return validatePerPage(o.PerPage, incrementalExportLimit)
+}
+
 // UserRoleText takes role type and returns role name string
 func UserRoleText(role int) string {
 	return userRoleText[role]
@@ -83,8 +107,16 @@ func UserRoleText(role int) string {
 type UserAPI interface {
 	GetUsers(ctx context.Context, opts *UserListOptions) ([]User, Page, error)
 	GetUser(ctx context.Context, userID int64) (User, error)
+	GetManyUsers(ctx context.Context, userIDs []int64) ([]User, error)
+	VerifyCredentials(ctx context.Context) (User, error)
 	CreateUser(ctx context.Context, user User) (User, error)
 	UpdateUser(ctx context.Context, userID int64, user User) (User, error)
+	SuspendUser(ctx context.Context, userID int64) (User, error)
+	UnsuspendUser(ctx context.Context, userID int64) (User, error)
+	DeleteUser(ctx context.Context, userID int64) (User, error)
+	PermanentlyDeleteUser(ctx context.Context, userID int64) error
+	MergeUsers(ctx context.Context, sourceUserID, targetUserID int64) (User, error)
+	GetIncrementalUsers(ctx context.Context, opts *UserIncrementalOptions, sideLoad ...sideload.SideLoader) ([]User, string, bool, error)
 }
 
 // GetUsers fetch user list
@@ -159,6 +191,60 @@ func (z *Client) GetUser(ctx context.Context, userID int64) (User, error) {
 	return result.User, nil
 }
 
+// VerifyCredentials gets the user the client is authenticated as.
+// It returns *AuthError if the credential is rejected, so that it can be used
+// as a startup check of both authentication and connectivity
+// ref: https://developer.zendesk.com/rest_api/docs/support/users#show-the-currently-authenticated-user
+func (z *Client) VerifyCredentials(ctx context.Context) (User, error) {
+	var result struct {
+		User User `json:"user"`
+	}
+
+	body, err := z.get(ctx, "/users/me.json")
+	if zerr, ok := err.(Error); ok && zerr.Status() == http.StatusUnauthorized {
+		return User{}, &AuthError{Err: zerr}
+	}
+	if err != nil {
+		return User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, err
+	}
+	return result.User, nil
+}
+
+// GetManyUsers get existing users by their ids.
+// It can take up to 100 ids
+// ref: https://developer.zendesk.com/rest_api/docs/support/users#show-many-users
+func (z *Client) GetManyUsers(ctx context.Context, userIDs []int64) ([]User, error) {
+	var result struct {
+		Users []User `json:"users"`
+	}
+
+	var req struct {
+		IDs string `url:"ids,omitempty"`
+	}
+	req.IDs = joinIDs(userIDs)
+
+	u, err := addOptions("/users/show_many.json", req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Users, nil
+}
+
 // UpdateUser update an existing user
 // ref: https://developer.zendesk.com/rest_api/docs/support/users#update-user
 func (z *Client) UpdateUser(ctx context.Context, userID int64, user User) (User, error) {
@@ -178,3 +264,154 @@ func (z *Client) UpdateUser(ctx context.Context, userID int64, user User) (User,
 	}
 	return result.User, nil
 }
+
+// SuspendUser suspends a user, which stops the user from signing in and
+// submitting requests
+// ref: https://developer.zendesk.com/rest_api/docs/support/users#suspending-a-user
+func (z *Client) SuspendUser(ctx context.Context, userID int64) (User, error) {
+	return z.setUserSuspended(ctx, userID, true)
+}
+
+// UnsuspendUser lifts the suspension of a user
+// ref: https://developer.zendesk.com/rest_api/docs/support/users#suspending-a-user
+func (z *Client) UnsuspendUser(ctx context.Context, userID int64) (User, error) {
+	return z.setUserSuspended(ctx, userID, false)
+}
+
+// setUserSuspended sends only the suspended flag, since User drops a false one
+func (z *Client) setUserSuspended(ctx context.Context, userID int64, suspended bool) (User, error) {
+	var data struct {
+		User struct {
+			Suspended bool `json:"suspended"`
+		} `json:"user"`
+	}
+	data.User.Suspended = suspended
+
+	var result struct {
+		User User `json:"user"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/users/%d.json", userID), data)
+	if err != nil {
+		return User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, err
+	}
+	return result.User, nil
+}
+
+// DeleteUser soft deletes a user and returns the deleted user.
+// The user stays in the deleted users list until PermanentlyDeleteUser is called
+// ref: https://developer.zendesk.com/rest_api/docs/support/users#delete-user
+func (z *Client) DeleteUser(ctx context.Context, userID int64) (User, error) {
+	var result struct {
+		User User `json:"user"`
+	}
+
+	body, err := z.send(ctx, http.MethodDelete, fmt.Sprintf("/users/%d.json", userID), nil, http.StatusOK)
+	if err != nil {
+		return User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, err
+	}
+	return result.User, nil
+}
+
+// PermanentlyDeleteUser permanently deletes a user which was deleted by DeleteUser.
+// It can't be undone
+// ref: https://developer.zendesk.com/rest_api/docs/support/users#permanently-delete-user
+func (z *Client) PermanentlyDeleteUser(ctx context.Context, userID int64) error {
+	_, err := z.send(ctx, http.MethodDelete, fmt.Sprintf("/deleted_users/%d.json", userID), nil, http.StatusOK)
+	return err
+}
+
+// MergeUsers merges the source user into the target user and returns the target user.
+// Only end users can be merged. Zendesk rejects other users, and the *Error
+// of the rejection is returned as is
+// ref: https://developer.zendesk.com/rest_api/docs/support/users#merge-end-users
+func (z *Client) MergeUsers(ctx context.Context, sourceUserID, targetUserID int64) (User, error) {
+	var data struct {
+		User struct {
+			ID int64 `json:"id"`
+		} `json:"user"`
+	}
+	data.User.ID = targetUserID
+
+	var result struct {
+		User User `json:"user"`
+	}
+
+	body, err := z.put(ctx, fmt.Sprintf("/users/%d/merge.json", sourceUserID), data)
+	if err != nil {
+		return User{}, err
+	}
+
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return User{}, err
+	}
+	return result.User, nil
+}
+
+// GetIncrementalUsers get a page of the cursor based incremental user export.
+// It returns the cursor of the next page and whether it's the end of stream.
+// Sideload identities with sideload.IncludeIdentities to attach each user's
+// identities to its Identities field, and organizations with sideload.Organizations
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/incremental_export#incremental-user-export
+func (z *Client) GetIncrementalUsers(ctx context.Context, opts *UserIncrementalOptions, sideLoad ...sideload.SideLoader) ([]User, string, bool, error) {
+	var data struct {
+		Users       []User                  `json:"users"`
+		Identities  []sideload.UserIdentity `json:"identities"`
+		AfterCursor string                  `json:"after_cursor"`
+		EoS         bool                    `json:"end_of_stream"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &UserIncrementalOptions{}
+	}
+
+	o := *tmp
+	o.Sideload = includeKeys(o.Sideload, sideLoad)
+
+	u, err := addOptions("/incremental/users/cursor.json", o)
+	if err != nil {
+		return nil, "", true, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, "", true, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, "", true, err
+	}
+
+	err = unmarshalSideLoads(body, sideLoad)
+	if err != nil {
+		return nil, "", true, err
+	}
+
+	if len(data.Identities) > 0 {
+		index := make(map[int64]int, len(data.Users))
+		for i, user := range data.Users {
+			index[user.ID] = i
+		}
+		for _, identity := range data.Identities {
+			if i, ok := index[identity.UserID]; ok {
+				data.Users[i].Identities = append(data.Users[i].Identities, identity)
+			}
+		}
+	}
+
+	return data.Users, data.AfterCursor, data.EoS, nil
+}
@@ -1,10 +1,14 @@
 package zendesk
 
 import (
+	"context"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"path/filepath"
 	"testing"
+
+	"github.com/tylerconlee/zendesk-go/zendesk/sideload"
 )
 
 func TestUserRoleText(t *testing.T) {
@@ -30,6 +34,29 @@ func TestGetUsers(t *testing.T) {
 	}
 }
 
+func TestGetManyUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/show_many.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		if ids := r.URL.Query().Get("ids"); ids != "369531345753,369537351454" {
+			t.Fatalf(`ids param expect "369531345753,369537351454", but got "%s"`, ids)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "users.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	users, err := client.GetManyUsers(ctx, []int64{369531345753, 369537351454})
+	if err != nil {
+		t.Fatalf("Failed to get many users: %s", err)
+	}
+
+	if len(users) != 2 {
+		t.Fatalf("expected length of users is 2, but got %d", len(users))
+	}
+}
+
 func TestGetUser(t *testing.T) {
 	mockAPI := newMockAPIWithStatus(http.MethodGet, "user.json", http.StatusOK)
 	client := newTestClient(mockAPI)
@@ -126,3 +153,192 @@ func TestUpdateUserFailure(t *testing.T) {
 		t.Fatal("Client did not return error when api failed")
 	}
 }
+
+func TestGetIncrementalUsersWithIdentities(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incremental/users/cursor.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		if include := r.URL.Query().Get("include"); include != "identities,organizations" {
+			t.Fatalf("include expect identities,organizations, but got %s", include)
+		}
+		if startTime := r.URL.Query().Get("start_time"); startTime != "1559347200" {
+			t.Fatalf("start_time expect 1559347200, but got %s", startTime)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "incremental_users.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var identities []sideload.UserIdentity
+	var orgs []Organization
+	users, cursor, eos, err := client.GetIncrementalUsers(ctx, &UserIncrementalOptions{StartTime: 1559347200},
+		sideload.IncludeIdentities(&identities), sideload.Organizations(&orgs))
+	if err != nil {
+		t.Fatalf("Failed to get incremental users: %s", err)
+	}
+
+	if len(users) != 2 {
+		t.Fatalf("expected length of users is 2, but got %d", len(users))
+	}
+	if len(identities) != 3 {
+		t.Fatalf("expected length of identities is 3, but got %d", len(identities))
+	}
+	if len(orgs) != 1 {
+		t.Fatalf("expected length of organizations is 1, but got %d", len(orgs))
+	}
+	if cursor != "MTU1OTU1NDgyMS4wfHwzNjk1MzEzNDU3NTR8" || !eos {
+		t.Fatalf("unexpected cursor %s or end of stream %v", cursor, eos)
+	}
+
+	if len(users[0].Identities) != 2 {
+		t.Fatalf("expected length of first user identities is 2, but got %d", len(users[0].Identities))
+	}
+	if identity := users[0].Identities[1]; identity.Type != "phone_number" || identity.Value != "+15551234567" {
+		t.Fatalf("unexpected identity %v", identity)
+	}
+	if len(users[1].Identities) != 1 || users[1].Identities[0].UserID != users[1].ID {
+		t.Fatalf("expected the second user to have its own identity, but got %v", users[1].Identities)
+	}
+}
+
+func TestSuspendUser(t *testing.T) {
+	type transition func(*Client, context.Context, int64) (User, error)
+
+	cases := []struct {
+		transition transition
+		expected   string
+	}{
+		{(*Client).SuspendUser, `{"user":{"suspended":true}}`},
+		{(*Client).UnsuspendUser, `{"user":{"suspended":false}}`},
+	}
+
+	for _, c := range cases {
+		mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPut || r.URL.Path != "/users/369531345753.json" {
+				t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			body, _ := ioutil.ReadAll(r.Body)
+			if string(body) != c.expected {
+				t.Fatalf("request body expect %s, but got %s", c.expected, string(body))
+			}
+			w.Write(readFixture(filepath.Join(http.MethodPut, "user.json")))
+		}))
+		client := newTestClient(mockAPI)
+
+		if _, err := c.transition(client, ctx, 369531345753); err != nil {
+			t.Fatalf("Failed to change suspension of user: %s", err)
+		}
+		mockAPI.Close()
+	}
+}
+
+func TestDeleteUser(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/users/369531345753.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "user.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.DeleteUser(ctx, 369531345753)
+	if err != nil {
+		t.Fatalf("Failed to delete user: %s", err)
+	}
+
+	if user.ID == 0 {
+		t.Fatal("expected the deleted user to be returned")
+	}
+}
+
+func TestPermanentlyDeleteUser(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/deleted_users/369531345753.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(`{"deleted_user":{"id":369531345753,"name":"Sample customer","active":false}}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	if err := client.PermanentlyDeleteUser(ctx, 369531345753); err != nil {
+		t.Fatalf("Failed to permanently delete user: %s", err)
+	}
+}
+
+func TestMergeUsers(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/users/1234/merge.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if expected := `{"user":{"id":369531345753}}`; string(body) != expected {
+			t.Fatalf("request body expect %s, but got %s", expected, string(body))
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "user.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.MergeUsers(ctx, 1234, 369531345753)
+	if err != nil {
+		t.Fatalf("Failed to merge users: %s", err)
+	}
+
+	if user.ID != 369531345753 {
+		t.Fatalf("expected the target user 369531345753 to be returned, but got %d", user.ID)
+	}
+}
+
+func TestMergeUsersRejectsAgents(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"error":"RecordInvalid","description":"Record validation errors","details":{"base":[{"description":"Only end-users can be merged"}]}}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.MergeUsers(ctx, 1234, 369531345753)
+	if zerr, ok := err.(Error); !ok || zerr.Status() != http.StatusUnprocessableEntity {
+		t.Fatalf("expected an unprocessable entity error, but got %v", err)
+	}
+}
+
+func TestVerifyCredentials(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/me.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "user.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	user, err := client.VerifyCredentials(ctx)
+	if err != nil {
+		t.Fatalf("Failed to verify credentials: %s", err)
+	}
+	if user.ID == 0 {
+		t.Fatal("expected the authenticated user to be returned")
+	}
+}
+
+func TestVerifyCredentialsUnauthorized(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"error":"Couldn't authenticate you"}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	_, err := client.VerifyCredentials(ctx)
+	authErr, ok := err.(*AuthError)
+	if !ok {
+		t.Fatalf("expected *AuthError, but got %v", err)
+	}
+	if authErr.Err.Status() != http.StatusUnauthorized {
+		t.Fatalf("expected status 401, but got %d", authErr.Err.Status())
+	}
+}
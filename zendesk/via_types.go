@@ -89,3 +89,31 @@ var viaTypeText = map[int]string{
 func ViaTypeText(viaID int) string {
 	return viaTypeText[viaID]
 }
+
+// ViaChannelSideConversation is the channel of comments created from side conversation replies
+const ViaChannelSideConversation = "side_conversation"
+
+// Via is information about how a ticket or an event was created
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/ticket_audits#the-via-object
+type Via struct {
+	Channel string    `json:"channel"`
+	Source  ViaSource `json:"source"`
+}
+
+// ViaSource is the source of Via. Its content depends on the channel
+type ViaSource struct {
+	From map[string]interface{} `json:"from,omitempty"`
+	To   map[string]interface{} `json:"to,omitempty"`
+	Rel  string                 `json:"rel,omitempty"`
+}
+
+// SideConversationID returns the id of the side conversation which the comment or
+// the event came from. It returns false if the channel isn't side_conversation
+func (v Via) SideConversationID() (string, bool) {
+	if v.Channel != ViaChannelSideConversation {
+		return "", false
+	}
+	id, ok := v.Source.From["id"].(string)
+	return id, ok && id != ""
+}
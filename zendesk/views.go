@@ -3,7 +3,9 @@ package zendesk
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 )
 
@@ -11,15 +13,12 @@ import (
 // observed from.
 //  https://developer.zendesk.com/rest_api/docs/support/views#json-format
 type View struct {
-	ID          int64  `json:"id,omitempty"`
-	Title       string `json:"title,omitempty"`
-	Active      bool   `json:"active,omitempty"`
-	Restriction struct {
-		Type string `json:"type,omitempty"`
-		ID   int64  `json:"id"`
-	} `json:"restriction,omitempty"`
-	Position  int64 `json:"position,omitempty"`
-	Execution struct {
+	ID          int64           `json:"id,omitempty"`
+	Title       string          `json:"title,omitempty"`
+	Active      bool            `json:"active,omitempty"`
+	Restriction ViewRestriction `json:"restriction,omitempty"`
+	Position    int64           `json:"position,omitempty"`
+	Execution   struct {
 		GroupBy    string `json:"group_by,omitempty"`
 		SortBy     string `json:"sort_by,omitempty"`
 		GroupOrder string `json:"group_order,omitempty"`
@@ -37,26 +36,55 @@ type View struct {
 			ID    string `json:"-"`
 			Title string `json:"-"`
 			Order string `json:"-"`
-		} `-"`
+		} `json:"-"`
 	} `json:"execution,omitempty"`
-	Conditions struct {
-		All []struct {
-			Field    string `json:"field,omitempty"`
-			Operator string `json:"operator,omitempty"`
-			Value    string `json:"value,omitempty"`
-		} `json:"all,omitempty"`
-		Any []struct {
-			Field    string `json:"field,omitempty"`
-			Operator string `json:"operator,omitempty"`
-			Value    string `json:"value,omitempty"`
-		} `json:"any,omitempty"`
-	} `json:"conditions,omitempty"`
-	Description string    `json:"description,omitempty"`
-	CreatedAt   time.Time `json:"created_at,omitempty"`
-	UpdatedAt   time.Time `json:"updated_at,omitempty"`
-}
-
-// ViewCount represents the return from the `count` endpoints
+	Conditions  Conditions `json:"conditions,omitempty"`
+	Description string     `json:"description,omitempty"`
+	CreatedAt   time.Time  `json:"created_at,omitempty"`
+	UpdatedAt   time.Time  `json:"updated_at,omitempty"`
+}
+
+// Types of ViewRestriction
+const (
+	ViewRestrictionGroup = "Group"
+	ViewRestrictionUser  = "User"
+)
+
+// ViewRestriction limits who can use a view. A view without Type is shared
+// with every agent of the account. A Group restriction allows the members of
+// the group ID, or of any group in IDs, and a User restriction is a personal view of ID
+type ViewRestriction struct {
+	Type string  `json:"type,omitempty"`
+	ID   int64   `json:"id"`
+	IDs  []int64 `json:"ids,omitempty"`
+}
+
+// AccessibleBy reports whether the user who belongs to groupIDs can use the view
+func (v View) AccessibleBy(userID int64, groupIDs []int64) bool {
+	r := v.Restriction
+	switch r.Type {
+	case "":
+		return true
+	case ViewRestrictionUser:
+		return r.ID == userID
+	case ViewRestrictionGroup:
+		for _, id := range groupIDs {
+			if id == r.ID {
+				return true
+			}
+			for _, rid := range r.IDs {
+				if id == rid {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// ViewCount represents the return from the `count` endpoints.
+// Counts are cached by Zendesk. Fresh is false while the cached Value is
+// stale and a new count is being calculated in the background
 type ViewCount struct {
 	ViewID int64  `json:"view_id,omitempty"`
 	URL    string `json:"url,omitempty"`
@@ -65,49 +93,289 @@ type ViewCount struct {
 	Fresh  bool   `json:"fresh,omitempty"`
 }
 
+// ErrViewCountStale is returned by RefreshViewCount when the count doesn't get fresh before the timeout
+var ErrViewCountStale = errors.New("view count is still stale")
+
+// viewCountPollInterval is the interval between polls of RefreshViewCount
+var viewCountPollInterval = 2 * time.Second
+
+// ViewListOptions is options for GetViewsWithOptions
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/views#list-views
+type ViewListOptions struct {
+	PageOptions
+
+	// Active filters views by active or inactive
+	Active *bool `url:"active,omitempty"`
+
+	// Access can take "personal", "shared" or "account"
+	Access string `url:"access,omitempty"`
+
+	// GroupID filters views by the group which they are restricted to
+	GroupID int64 `url:"group_id,omitempty"`
+
+	// AccessibleToGroup filters views by the group whose agents can use them
+	AccessibleToGroup int64 `url:"accessible_to_group,omitempty"`
+
+	// SortBy can take "alphabetical", "created_at" or "updated_at"
+	SortBy string `url:"sort_by,omitempty"`
+
+	// SortOrder can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// ViewExecuteOptions is options for ExecuteView
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/views#execute-view
+type ViewExecuteOptions struct {
+	PageOptions
+
+	// SortBy can take a column id of the view
+	SortBy string `url:"sort_by,omitempty"`
+
+	// SortOrder can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+
+	// GroupBy can take a column id of the view
+	GroupBy string `url:"group_by,omitempty"`
+
+	// GroupOrder can take "asc" or "desc"
+	GroupOrder string `url:"group_order,omitempty"`
+}
+
+// ViewTicketListOptions is options for GetViewTickets
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/views#list-tickets-from-a-view
+type ViewTicketListOptions struct {
+	PageOptions
+
+	// SortBy can take a column id of the view
+	SortBy string `url:"sort_by,omitempty"`
+
+	// SortOrder can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// ViewTicketCursorOptions is options for GetViewTicketsCursor
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/views#list-tickets-from-a-view
+type ViewTicketCursorOptions struct {
+	CursorOptions
+
+	// SortBy can take a column id of the view
+	SortBy string `url:"sort_by,omitempty"`
+
+	// SortOrder can take "asc" or "desc"
+	SortOrder string `url:"sort_order,omitempty"`
+}
+
+// ViewColumn is a column of view execution result.
+// ID is field name for system fields and field id for custom fields
+type ViewColumn struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// UnmarshalJSON is unmarshaller for ViewColumn which converts
+// numeric id of custom field column to string
+func (c *ViewColumn) UnmarshalJSON(data []byte) error {
+	var tmp struct {
+		ID    interface{} `json:"id"`
+		Title string      `json:"title"`
+	}
+
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	switch id := tmp.ID.(type) {
+	case string:
+		c.ID = id
+	case float64:
+		c.ID = strconv.FormatInt(int64(id), 10)
+	case nil:
+		c.ID = ""
+	default:
+		return fmt.Errorf("%T is an invalid type for view column id", id)
+	}
+
+	c.Title = tmp.Title
+	return nil
+}
+
+// ViewRow is a row of view execution result.
+// Ticket holds the ticket of the row and Fields holds the other values keyed by column id
+type ViewRow struct {
+	Ticket Ticket
+	Fields map[string]interface{}
+}
+
+// UnmarshalJSON is unmarshaller for ViewRow
+func (r *ViewRow) UnmarshalJSON(data []byte) error {
+	var tmp map[string]json.RawMessage
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	row := ViewRow{Fields: map[string]interface{}{}}
+	for key, raw := range tmp {
+		switch key {
+		case "ticket":
+			if err := json.Unmarshal(raw, &row.Ticket); err != nil {
+				return err
+			}
+		case "custom_fields":
+			var fields []struct {
+				ID    int64       `json:"id"`
+				Value interface{} `json:"value"`
+			}
+			if err := json.Unmarshal(raw, &fields); err != nil {
+				return err
+			}
+			for _, f := range fields {
+				row.Fields[strconv.FormatInt(f.ID, 10)] = f.Value
+			}
+		default:
+			var v interface{}
+			if err := json.Unmarshal(raw, &v); err != nil {
+				return err
+			}
+			row.Fields[key] = v
+		}
+	}
+
+	*r = row
+	return nil
+}
+
+// Value returns the value of the specified column in the row
+func (r ViewRow) Value(columnID string) interface{} {
+	return r.Fields[columnID]
+}
+
+// ViewRows is the result of view execution
+type ViewRows struct {
+	Columns []ViewColumn `json:"columns"`
+	Rows    []ViewRow    `json:"rows"`
+}
+
 // ViewAPI is an interface containing all view related methods
 type ViewAPI interface {
 	GetViews(ctx context.Context) ([]View, Page, error)
+	GetViewsWithOptions(ctx context.Context, opts *ViewListOptions) ([]View, Page, error)
 	GetActiveViews(ctx context.Context) ([]View, Page, error)
-	GetViewCount(ctx context.Context, viewID int) (ViewCount, error)
-	GetView(ctx context.Context, viewID int) (View, error)
+	GetCompactViews(ctx context.Context) ([]View, Page, error)
+	GetViewsByGroup(ctx context.Context, groupID int64) ([]View, error)
+	GetAccessibleViews(ctx context.Context, userID int64, groupIDs []int64, opts *ViewListOptions) ([]View, error)
+	GetViewCount(ctx context.Context, viewID int64) (ViewCount, error)
+	RefreshViewCount(ctx context.Context, viewID int64, timeout time.Duration) (ViewCount, error)
+	GetView(ctx context.Context, viewID int64) (View, error)
 	CreateView(ctx context.Context, view View) (View, error)
-	UpdateView(ctx context.Context, viewID int, view View) (View, error)
+	UpdateView(ctx context.Context, viewID int64, view View) (View, error)
+	ExecuteView(ctx context.Context, viewID int64, opts *ViewExecuteOptions) (ViewRows, Page, error)
+	GetViewTickets(ctx context.Context, viewID int64, opts *ViewTicketListOptions) ([]Ticket, Page, error)
+	GetViewTicketsCursor(ctx context.Context, viewID int64, opts *ViewTicketCursorOptions) ([]Ticket, CursorMeta, error)
+	ExportView(ctx context.Context, viewID int64) (<-chan Ticket, <-chan error)
+	DeleteView(ctx context.Context, viewID int64) error
+	GetViewCountMany(ctx context.Context, viewIDs []int64) ([]ViewCount, error)
+	ReorderViews(ctx context.Context, viewIDs []int64) error
+	UpdateManyViews(ctx context.Context, views []View) ([]View, error)
+	DestroyManyViews(ctx context.Context, viewIDs []int64) error
 }
 
 // GetViews gets a list of all of the current views (active & deactivated)
 // Endpoint: GET /api/v2/views.json
 // https://developer.zendesk.com/rest_api/docs/support/views#list-views
 func (z *Client) GetViews(ctx context.Context) ([]View, Page, error) {
-	var data struct {
-		Views []View `json:"views"`
-		Page
-	}
-	u, err := addOptions("/views.json", nil)
-	if err != nil {
-		return nil, Page{}, err
-	}
-	body, err := z.get(ctx, u)
-	if err != nil {
-		return nil, Page{}, err
-	}
+	return z.GetViewsWithOptions(ctx, nil)
+}
 
-	err = json.Unmarshal(body, &data)
-	if err != nil {
-		return nil, Page{}, err
-	}
-	return data.Views, data.Page, nil
+// GetViewsWithOptions gets a list of views filtered and paged by opts.
+// opts can be nil to list views with default parameters
+// Endpoint: GET /api/v2/views.json
+// https://developer.zendesk.com/rest_api/docs/support/views#list-views
+func (z *Client) GetViewsWithOptions(ctx context.Context, opts *ViewListOptions) ([]View, Page, error) {
+	return z.getViews(ctx, "/views.json", opts)
 }
 
 // GetActiveViews gets a list of all of the current active views
 // Endpoint: GET /api/v2/views/active.json
 // https://developer.zendesk.com/rest_api/docs/support/views#list-active-views
 func (z *Client) GetActiveViews(ctx context.Context) ([]View, Page, error) {
+	return z.getViews(ctx, "/views/active.json", nil)
+}
+
+// GetCompactViews gets a compacted list of shared and personal views
+// Endpoint: GET /api/v2/views/compact.json
+// https://developer.zendesk.com/rest_api/docs/support/views#list-views---compact
+func (z *Client) GetCompactViews(ctx context.Context) ([]View, Page, error) {
+	return z.getViews(ctx, "/views/compact.json", nil)
+}
+
+// GetViewsByGroup gets every view restricted to the group
+// Endpoint: GET /api/v2/views.json?group_id={group_id}
+// https://developer.zendesk.com/rest_api/docs/support/views#list-views
+func (z *Client) GetViewsByGroup(ctx context.Context, groupID int64) ([]View, error) {
+	return z.getAllViews(ctx, &ViewListOptions{GroupID: groupID})
+}
+
+// GetAccessibleViews gets every view matching opts which the user who belongs to groupIDs can use.
+// Access of opts narrows the list to "personal", "shared" or "account" views,
+// and restrictions of the views are checked with AccessibleBy
+// Endpoint: GET /api/v2/views.json
+// https://developer.zendesk.com/rest_api/docs/support/views#list-views
+func (z *Client) GetAccessibleViews(ctx context.Context, userID int64, groupIDs []int64, opts *ViewListOptions) ([]View, error) {
+	views, err := z.getAllViews(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	accessible := make([]View, 0, len(views))
+	for _, v := range views {
+		if v.AccessibleBy(userID, groupIDs) {
+			accessible = append(accessible, v)
+		}
+	}
+	return accessible, nil
+}
+
+// getAllViews follows the pages of GetViewsWithOptions
+func (z *Client) getAllViews(ctx context.Context, opts *ViewListOptions) ([]View, error) {
+	tmp := ViewListOptions{}
+	if opts != nil {
+		tmp = *opts
+	}
+	tmp.PerPage = MaxPerPage
+
+	var all []View
+	for {
+		views, page, err := z.GetViewsWithOptions(ctx, &tmp)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, views...)
+
+		next, ok := page.NextPageNumber()
+		if !ok {
+			return all, nil
+		}
+		tmp.Page = next
+	}
+}
+
+// getViews gets a list of views from the specified listing endpoint
+func (z *Client) getViews(ctx context.Context, path string, opts *ViewListOptions) ([]View, Page, error) {
 	var data struct {
 		Views []View `json:"views"`
 		Page
 	}
-	u, err := addOptions("/views/active.json", nil)
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ViewListOptions{}
+	}
+
+	u, err := addOptions(path, tmp)
 	if err != nil {
 		return nil, Page{}, err
 	}
@@ -150,6 +418,33 @@ func (z *Client) GetViewCount(ctx context.Context, viewID int64) (ViewCount, err
 	return result.ViewCount, nil
 }
 
+// RefreshViewCount polls the count of tickets in a given view until it gets fresh.
+// Requesting a stale count makes Zendesk calculate a new one, so this keeps polling
+// until Fresh is true. If the count is still stale after timeout, the last stale
+// count is returned with ErrViewCountStale
+// Endpoint: GET /api/v2/views/{id}/count.json
+// https://developer.zendesk.com/rest_api/docs/support/views#get-view-count
+func (z *Client) RefreshViewCount(ctx context.Context, viewID int64, timeout time.Duration) (ViewCount, error) {
+	deadline := time.After(timeout)
+	for {
+		count, err := z.GetViewCount(ctx, viewID)
+		if err != nil {
+			return ViewCount{}, err
+		}
+		if count.Fresh {
+			return count, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return count, ctx.Err()
+		case <-deadline:
+			return count, ErrViewCountStale
+		case <-time.After(viewCountPollInterval):
+		}
+	}
+}
+
 // GetView gets the details of a specified view
 // Endpoint: GET /api/v2/views/{ID}.json
 // https://developer.zendesk.com/rest_api/docs/support/views#show-view
@@ -182,7 +477,7 @@ func (z *Client) GetView(ctx context.Context, viewID int64) (View, error) {
 // https://developer.zendesk.com/rest_api/docs/support/views#create-view
 func (z *Client) CreateView(ctx context.Context, view View) (View, error) {
 	var data, result struct {
-		View View `json:"View"`
+		View View `json:"view"`
 	}
 	data.View = view
 
@@ -202,12 +497,15 @@ func (z *Client) CreateView(ctx context.Context, view View) (View, error) {
 // https://developer.zendesk.com/rest_api/docs/support/views#update-view
 func (z *Client) UpdateView(ctx context.Context, viewID int64, view View) (View, error) {
 	var data, result struct {
-		View View `json:"View"`
+		View View `json:"view"`
 	}
 	data.View = view
 	var builder includeBuilder
 
 	u, err := builder.path(fmt.Sprintf("/views/%d.json", viewID))
+	if err != nil {
+		return View{}, err
+	}
 
 	body, err := z.put(ctx, u, data)
 	if err != nil {
@@ -219,3 +517,279 @@ func (z *Client) UpdateView(ctx context.Context, viewID int64, view View) (View,
 	}
 	return result.View, nil
 }
+
+// ExecuteView executes the specified view and returns the column-projected rows
+// Endpoint: GET /api/v2/views/{ID}/execute.json
+// https://developer.zendesk.com/rest_api/docs/support/views#execute-view
+func (z *Client) ExecuteView(ctx context.Context, viewID int64, opts *ViewExecuteOptions) (ViewRows, Page, error) {
+	var data struct {
+		ViewRows
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ViewExecuteOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/views/%d/execute.json", viewID), tmp)
+	if err != nil {
+		return ViewRows{}, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return ViewRows{}, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return ViewRows{}, Page{}, err
+	}
+	return data.ViewRows, data.Page, nil
+}
+
+// GetViewTickets gets the tickets matched by the specified view
+// Endpoint: GET /api/v2/views/{ID}/tickets.json
+// https://developer.zendesk.com/rest_api/docs/support/views#list-tickets-from-a-view
+func (z *Client) GetViewTickets(ctx context.Context, viewID int64, opts *ViewTicketListOptions) ([]Ticket, Page, error) {
+	var data struct {
+		Tickets []Ticket `json:"tickets"`
+		Page
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ViewTicketListOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/views/%d/tickets.json", viewID), tmp)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, Page{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, Page{}, err
+	}
+	return data.Tickets, data.Page, nil
+}
+
+// GetViewTicketsCursor gets a page of tickets matched by the specified view with
+// cursor based pagination, which has no limit of offset unlike GetViewTickets.
+// Pass AfterCursor of the returned meta as PageAfter to get the next page while HasMore is true
+// Endpoint: GET /api/v2/views/{ID}/tickets.json
+// https://developer.zendesk.com/rest_api/docs/support/views#list-tickets-from-a-view
+func (z *Client) GetViewTicketsCursor(ctx context.Context, viewID int64, opts *ViewTicketCursorOptions) ([]Ticket, CursorMeta, error) {
+	var data struct {
+		Tickets []Ticket   `json:"tickets"`
+		Meta    CursorMeta `json:"meta"`
+	}
+
+	tmp := opts
+	if tmp == nil {
+		tmp = &ViewTicketCursorOptions{}
+	}
+
+	u, err := addOptions(fmt.Sprintf("/views/%d/tickets.json", viewID), tmp)
+	if err != nil {
+		return nil, CursorMeta{}, err
+	}
+
+	body, err := z.get(ctx, u)
+	if err != nil {
+		return nil, CursorMeta{}, err
+	}
+
+	err = json.Unmarshal(body, &data)
+	if err != nil {
+		return nil, CursorMeta{}, err
+	}
+	return data.Tickets, data.Meta, nil
+}
+
+// ExportView streams every ticket matched by the specified view.
+// It follows the cursors of GetViewTicketsCursor until the last page, so callers can
+// process tickets without holding all of them in memory.
+// The ticket channel is closed when the export ends. At most one error,
+// including the error of cancelled ctx, is sent to the error channel before it is closed
+// Endpoint: GET /api/v2/views/{ID}/tickets.json
+// https://developer.zendesk.com/rest_api/docs/support/views#list-tickets-from-a-view
+func (z *Client) ExportView(ctx context.Context, viewID int64) (<-chan Ticket, <-chan error) {
+	tickets := make(chan Ticket)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(tickets)
+
+		opts := &ViewTicketCursorOptions{
+			CursorOptions: CursorOptions{PageSize: MaxPerPage},
+		}
+		for {
+			list, meta, err := z.GetViewTicketsCursor(ctx, viewID, opts)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, t := range list {
+				select {
+				case tickets <- t:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			if !meta.HasMore || meta.AfterCursor == "" || meta.AfterCursor == opts.PageAfter {
+				return
+			}
+			opts.PageAfter = meta.AfterCursor
+		}
+	}()
+
+	return tickets, errs
+}
+
+// DeleteView deletes the specified view
+// Endpoint: DELETE /api/v2/views/{ID}.json
+// https://developer.zendesk.com/rest_api/docs/support/views#delete-view
+func (z *Client) DeleteView(ctx context.Context, viewID int64) error {
+	err := z.delete(ctx, fmt.Sprintf("/views/%d.json", viewID))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetViewCountMany gets the ticket counts of multiple views.
+// Ids are sent in batches of 100
+// Endpoint: GET /api/v2/views/count_many.json?ids={ids}
+// https://developer.zendesk.com/rest_api/docs/support/views#get-view-counts
+func (z *Client) GetViewCountMany(ctx context.Context, viewIDs []int64) ([]ViewCount, error) {
+	if len(viewIDs) == 0 {
+		return nil, fmt.Errorf("no views to count")
+	}
+
+	var counts []ViewCount
+	for _, ids := range chunkIDs(viewIDs, manyLimit) {
+		var result struct {
+			ViewCounts []ViewCount `json:"view_counts"`
+		}
+
+		var req struct {
+			IDs string `url:"ids,omitempty"`
+		}
+		req.IDs = joinIDs(ids)
+
+		u, err := addOptions("/views/count_many.json", req)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := z.get(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			return nil, err
+		}
+		counts = append(counts, result.ViewCounts...)
+	}
+	return counts, nil
+}
+
+// ReorderViews updates the positions of views in the order of given IDs.
+// Positions are sent in batches of 100
+// Endpoint: PUT /api/v2/views/update_many.json
+// https://developer.zendesk.com/rest_api/docs/support/views#update-many-views
+func (z *Client) ReorderViews(ctx context.Context, viewIDs []int64) error {
+	if len(viewIDs) == 0 {
+		return fmt.Errorf("no views to reorder")
+	}
+
+	type position struct {
+		ID       int64 `json:"id"`
+		Position int64 `json:"position"`
+	}
+
+	for _, c := range chunk(len(viewIDs), manyLimit) {
+		var data struct {
+			Views []position `json:"views"`
+		}
+		for i := c.start; i < c.end; i++ {
+			data.Views = append(data.Views, position{ID: viewIDs[i], Position: int64(i + 1)})
+		}
+
+		if _, err := z.put(ctx, "/views/update_many.json", data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateManyViews updates multiple views at once. Each view must have its ID.
+// Views are sent in batches of 100
+// Endpoint: PUT /api/v2/views/update_many.json
+// https://developer.zendesk.com/rest_api/docs/support/views#update-many-views
+func (z *Client) UpdateManyViews(ctx context.Context, views []View) ([]View, error) {
+	if len(views) == 0 {
+		return nil, fmt.Errorf("no views to update")
+	}
+
+	var updated []View
+	for _, c := range chunk(len(views), manyLimit) {
+		var data, result struct {
+			Views []View `json:"views"`
+		}
+		data.Views = views[c.start:c.end]
+
+		body, err := z.put(ctx, "/views/update_many.json", data)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			return nil, err
+		}
+		updated = append(updated, result.Views...)
+	}
+	return updated, nil
+}
+
+// DestroyManyViews deletes multiple views at once. Ids are sent in batches of 100
+// Endpoint: DELETE /api/v2/views/destroy_many.json?ids={ids}
+// https://developer.zendesk.com/rest_api/docs/support/views#delete-many-views
+func (z *Client) DestroyManyViews(ctx context.Context, viewIDs []int64) error {
+	if len(viewIDs) == 0 {
+		return fmt.Errorf("no views to delete")
+	}
+
+	for _, ids := range chunkIDs(viewIDs, manyLimit) {
+		var req struct {
+			IDs string `url:"ids,omitempty"`
+		}
+		req.IDs = joinIDs(ids)
+
+		u, err := addOptions("/views/destroy_many.json", req)
+		if err != nil {
+			return err
+		}
+
+		err = z.delete(ctx, u)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
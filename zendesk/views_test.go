@@ -0,0 +1,537 @@
+package zendesk
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExecuteView(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/views/360002440594/execute.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		if q := r.URL.Query(); q.Get("sort_by") != "locale" || q.Get("sort_order") != "desc" {
+			t.Fatalf("Unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "view_execute.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	rows, _, err := client.ExecuteView(ctx, 360002440594, &ViewExecuteOptions{
+		SortBy:    "locale",
+		SortOrder: "desc",
+	})
+	if err != nil {
+		t.Fatalf("Failed to execute view: %s", err)
+	}
+
+	if len(rows.Columns) != 3 {
+		t.Fatalf("expected length of columns is 3, but got %d", len(rows.Columns))
+	}
+	if rows.Columns[2].ID != "360005657120" || rows.Columns[2].Title != "Account" {
+		t.Fatalf("Custom field column was not parsed as expected: %v", rows.Columns[2])
+	}
+
+	if len(rows.Rows) != 2 {
+		t.Fatalf("expected length of rows is 2, but got %d", len(rows.Rows))
+	}
+
+	row := rows.Rows[0]
+	if row.Ticket.ID != 2 {
+		t.Fatalf("Row ticket does not have the expected ID 2. Ticket id is %d", row.Ticket.ID)
+	}
+	if v := row.Value("locale"); v != "en-US" {
+		t.Fatalf("locale column expect en-US, but got %v", v)
+	}
+	if v := row.Value(rows.Columns[2].ID); v != "Custom field value for testing" {
+		t.Fatalf("custom field column has unexpected value %v", v)
+	}
+}
+
+func TestGetViewTickets(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/views/360002440594/tickets.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "tickets.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, _, err := client.GetViewTickets(ctx, 360002440594, nil)
+	if err != nil {
+		t.Fatalf("Failed to get view tickets: %s", err)
+	}
+
+	if len(tickets) != 2 {
+		t.Fatalf("expected length of tickets is 2, but got %d", len(tickets))
+	}
+}
+
+func newViewTicketsPagesMockAPI(t *testing.T, failPage int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if size := r.URL.Query().Get("page[size]"); size != "100" {
+			t.Fatalf("Request does not have expected page[size] param: %s", r.URL.RawQuery)
+		}
+
+		// Cursor "cN" points to the page after page N
+		page := 1
+		if after := r.URL.Query().Get("page[after]"); after != "" {
+			n, err := strconv.Atoi(strings.TrimPrefix(after, "c"))
+			if err != nil {
+				t.Fatalf("Request does not have valid page[after] param: %s", r.URL.RawQuery)
+			}
+			page = n + 1
+		}
+		if page == failPage {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		fmt.Fprintf(w, `{"tickets":[{"id":%d},{"id":%d}],"meta":{"has_more":%t,"after_cursor":"c%d","before_cursor":null}}`, page*2-1, page*2, page < 3, page)
+	}))
+}
+
+func TestExportView(t *testing.T) {
+	mockAPI := newViewTicketsPagesMockAPI(t, 0)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, errs := client.ExportView(ctx, 1)
+
+	var ids []int64
+	for ticket := range tickets {
+		ids = append(ids, ticket.ID)
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("Failed to export view: %s", err)
+	}
+
+	if len(ids) != 6 || ids[0] != 1 || ids[5] != 6 {
+		t.Fatalf("Tickets of all pages were not exported in order: %v", ids)
+	}
+}
+
+func TestExportViewError(t *testing.T) {
+	mockAPI := newViewTicketsPagesMockAPI(t, 2)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	tickets, errs := client.ExportView(ctx, 1)
+
+	count := 0
+	for range tickets {
+		count++
+	}
+	if err := <-errs; err == nil {
+		t.Fatal("Error of a failed page was not propagated")
+	}
+	if count != 2 {
+		t.Fatalf("expected number of tickets before the error is 2, but got %d", count)
+	}
+}
+
+func TestExportViewCanceledContext(t *testing.T) {
+	mockAPI := newViewTicketsPagesMockAPI(t, 0)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	canceled, cancel := context.WithCancel(ctx)
+	tickets, errs := client.ExportView(canceled, 1)
+
+	<-tickets
+	cancel()
+	for range tickets {
+	}
+	if err := <-errs; err == nil {
+		t.Fatal("Did not get error when the context was cancelled")
+	}
+}
+
+func TestDeleteView(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/views/25.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusNoContent)
+		w.Write(nil)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DeleteView(ctx, 25)
+	if err != nil {
+		t.Fatalf("Failed to delete view: %s", err)
+	}
+}
+
+func TestGetViewCountMany(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ids := r.URL.Query().Get("ids"); ids != "25,78" {
+			t.Fatalf(`ids param expect "25,78", but got "%s"`, ids)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "view_count_many.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	counts, err := client.GetViewCountMany(ctx, []int64{25, 78})
+	if err != nil {
+		t.Fatalf("Failed to get view counts: %s", err)
+	}
+
+	if len(counts) != 2 {
+		t.Fatalf("expected length of view counts is 2, but got %d", len(counts))
+	}
+	if counts[0].ViewID != 25 || counts[0].Value != 719 || !counts[0].Fresh {
+		t.Fatalf("View count was not parsed as expected: %v", counts[0])
+	}
+	if counts[1].ViewID != 78 || counts[1].Fresh {
+		t.Fatalf("View count was not parsed as expected: %v", counts[1])
+	}
+}
+
+func TestGetViewCountManyChunked(t *testing.T) {
+	var requests []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.Query().Get("ids"))
+		w.Write(readFixture(filepath.Join(http.MethodGet, "view_count_many.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ids := make([]int64, 150)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	counts, err := client.GetViewCountMany(ctx, ids)
+	if err != nil {
+		t.Fatalf("Failed to get view counts: %s", err)
+	}
+
+	if len(requests) != 2 || !strings.HasPrefix(requests[1], "101,") {
+		t.Fatalf("expected ids to be sent in 2 batches, but got %v", requests)
+	}
+	if len(counts) != 4 {
+		t.Fatalf("expected view counts of both batches, but got %d", len(counts))
+	}
+}
+
+func newViewCountSequenceMockAPI(t *testing.T, fresh ...bool) (*httptest.Server, *int) {
+	calls := 0
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/views/25/count.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		f := fresh[len(fresh)-1]
+		if calls < len(fresh) {
+			f = fresh[calls]
+		}
+		calls++
+		fmt.Fprintf(w, `{"view_count":{"view_id":25,"value":%d,"pretty":"~%d","fresh":%t}}`, 700+calls, 700+calls, f)
+	}))
+	return mockAPI, &calls
+}
+
+func TestRefreshViewCount(t *testing.T) {
+	defer func(d time.Duration) { viewCountPollInterval = d }(viewCountPollInterval)
+	viewCountPollInterval = time.Millisecond
+
+	mockAPI, calls := newViewCountSequenceMockAPI(t, false, false, true)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	count, err := client.RefreshViewCount(ctx, 25, time.Second)
+	if err != nil {
+		t.Fatalf("Failed to refresh view count: %s", err)
+	}
+
+	if *calls != 3 {
+		t.Fatalf("expected count to be polled 3 times, but got %d", *calls)
+	}
+	if !count.Fresh || count.Value != 703 {
+		t.Fatalf("expected the fresh count 703, but got %v", count)
+	}
+}
+
+func TestRefreshViewCountTimeout(t *testing.T) {
+	defer func(d time.Duration) { viewCountPollInterval = d }(viewCountPollInterval)
+	viewCountPollInterval = time.Millisecond
+
+	mockAPI, _ := newViewCountSequenceMockAPI(t, false)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	count, err := client.RefreshViewCount(ctx, 25, 20*time.Millisecond)
+	if err != ErrViewCountStale {
+		t.Fatalf("expected ErrViewCountStale, but got %v", err)
+	}
+	if count.Fresh || count.ViewID != 25 {
+		t.Fatalf("expected the last stale count, but got %v", count)
+	}
+}
+
+func TestReorderViews(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/views/update_many.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		expected := `{"views":[{"id":78,"position":1},{"id":25,"position":2}]}`
+		if string(body) != expected {
+			t.Fatalf("\nExpect:\t%s\nGot:\t%s", expected, body)
+		}
+		w.Write([]byte(`{"views":[]}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.ReorderViews(ctx, []int64{78, 25})
+	if err != nil {
+		t.Fatalf("Failed to reorder views: %s", err)
+	}
+}
+
+func TestReorderViewsChunked(t *testing.T) {
+	var batches []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		batches = append(batches, string(body))
+		w.Write([]byte(`{"views":[]}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	ids := make([]int64, 150)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+
+	err := client.ReorderViews(ctx, ids)
+	if err != nil {
+		t.Fatalf("Failed to reorder views: %s", err)
+	}
+
+	if len(batches) != 2 {
+		t.Fatalf("expected 2 batches, but got %d", len(batches))
+	}
+	if !strings.HasPrefix(batches[1], `{"views":[{"id":101,"position":101}`) {
+		t.Fatalf("second batch has unexpected positions %s", batches[1])
+	}
+}
+
+func TestDestroyManyViews(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/views/destroy_many.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if ids := r.URL.Query().Get("ids"); ids != "1,2,3" {
+			t.Fatalf(`ids param expect "1,2,3", but got "%s"`, ids)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	err := client.DestroyManyViews(ctx, []int64{1, 2, 3})
+	if err != nil {
+		t.Fatalf("Failed to destroy views: %s", err)
+	}
+}
+
+func TestCreateView(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if !strings.HasPrefix(string(body), `{"view":{`) {
+			t.Fatalf(`Request body is not wrapped by "view" key: %s`, body)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write(readFixture(filepath.Join(http.MethodPost, "view.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	view, err := client.CreateView(ctx, View{Title: "Roger Wilco"})
+	if err != nil {
+		t.Fatalf("Failed to create view: %s", err)
+	}
+
+	expectedID := int64(360002440594)
+	if view.ID != expectedID {
+		t.Fatalf("Returned view does not have the expected ID %d. View id is %d", expectedID, view.ID)
+	}
+}
+
+func TestUpdateView(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if !strings.HasPrefix(string(body), `{"view":{`) {
+			t.Fatalf(`Request body is not wrapped by "view" key: %s`, body)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodPut, "view.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	view, err := client.UpdateView(ctx, 360002440594, View{Title: "Roger Wilco II"})
+	if err != nil {
+		t.Fatalf("Failed to update view: %s", err)
+	}
+
+	if view.Title != "Roger Wilco II" {
+		t.Fatalf("Returned view does not have the expected title. View title is %s", view.Title)
+	}
+}
+
+func TestGetViews(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "views.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	views, _, err := client.GetViews(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get views: %s", err)
+	}
+
+	if len(views) != 2 {
+		t.Fatalf("expected length of views is 2, but got %d", len(views))
+	}
+}
+
+func TestGetViewsWithOptions(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		expected := "accessible_to_group=360004077473&active=false&group_id=360004077472&page=2&per_page=10&sort_by=alphabetical&sort_order=asc"
+		if r.URL.RawQuery != expected {
+			t.Fatalf("\nExpect:\t%s\nGot:\t%s", expected, r.URL.RawQuery)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "views.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	active := false
+	views, _, err := client.GetViewsWithOptions(ctx, &ViewListOptions{
+		PageOptions: PageOptions{
+			Page:    2,
+			PerPage: 10,
+		},
+		Active:            &active,
+		GroupID:           360004077472,
+		AccessibleToGroup: 360004077473,
+		SortBy:            "alphabetical",
+		SortOrder:         "asc",
+	})
+	if err != nil {
+		t.Fatalf("Failed to get views: %s", err)
+	}
+
+	if len(views) != 2 {
+		t.Fatalf("expected length of views is 2, but got %d", len(views))
+	}
+}
+
+func TestGetCompactViews(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/views/compact.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "views.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	views, _, err := client.GetCompactViews(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get compact views: %s", err)
+	}
+
+	if len(views) != 2 {
+		t.Fatalf("expected length of views is 2, but got %d", len(views))
+	}
+}
+
+func TestViewConditionsUnmarshal(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodPost, "view.json", http.StatusCreated)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	view, err := client.CreateView(ctx, View{
+		Title:      "Roger Wilco",
+		Conditions: NewConditions().All("status", "less_than", "solved").Build(),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create view: %s", err)
+	}
+
+	if len(view.Conditions.All) != 1 || view.Conditions.All[0].Operator != "less_than" {
+		t.Fatalf("View conditions were not parsed as expected: %v", view.Conditions)
+	}
+}
+
+func TestGetAccessibleViews(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/views.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		if access := r.URL.Query().Get("access"); access != "shared" {
+			t.Fatalf("access expect shared, but got %s", access)
+		}
+		w.Write([]byte(`{"views":[
+			{"id":1,"title":"All unsolved","restriction":null},
+			{"id":2,"title":"Billing","restriction":{"type":"Group","id":10}},
+			{"id":3,"title":"Support and Sales","restriction":{"type":"Group","id":20,"ids":[20,30]}},
+			{"id":4,"title":"Mine","restriction":{"type":"User","id":100}},
+			{"id":5,"title":"Someone else's","restriction":{"type":"User","id":200}}
+		],"next_page":null,"previous_page":null,"count":5}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	views, err := client.GetAccessibleViews(ctx, 100, []int64{30}, &ViewListOptions{Access: "shared"})
+	if err != nil {
+		t.Fatalf("Failed to get accessible views: %s", err)
+	}
+
+	var ids []int64
+	for _, v := range views {
+		ids = append(ids, v.ID)
+	}
+	if expected := []int64{1, 3, 4}; !reflect.DeepEqual(ids, expected) {
+		t.Fatalf("expected accessible views %v, but got %v", expected, ids)
+	}
+	if r := views[1].Restriction; r.Type != ViewRestrictionGroup || len(r.IDs) != 2 {
+		t.Fatalf("restriction was not parsed as expected: %v", r)
+	}
+}
+
+func TestGetViewsByGroup(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if groupID := r.URL.Query().Get("group_id"); groupID != "10" {
+			t.Fatalf("group_id expect 10, but got %s", groupID)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "views.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	views, err := client.GetViewsByGroup(ctx, 10)
+	if err != nil {
+		t.Fatalf("Failed to get views by group: %s", err)
+	}
+
+	if len(views) != 2 {
+		t.Fatalf("expected length of views is 2, but got %d", len(views))
+	}
+}
@@ -1,25 +1,36 @@
 package zendesk
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-querystring/query"
 )
 
 const (
-	baseURLFormat = "https://%s.zendesk.com/api/v2"
+	// DefaultAPIPrefix is the path prefix of API which SetSubdomain uses
+	DefaultAPIPrefix = "/api/v2"
+
+	baseURLFormat = "https://%s.zendesk.com" + DefaultAPIPrefix
+
+	// DefaultTimeout is the timeout of the HTTP client created by NewClient(nil)
+	DefaultTimeout = 60 * time.Second
 )
 
 var defaultHeaders = map[string]string{
-	"User-Agent":   "nukosuke/go-zendesk",
-	"Content-Type": "application/json",
+	"User-Agent":      "nukosuke/go-zendesk",
+	"Content-Type":    "application/json",
+	"Accept-Encoding": "gzip",
 }
 
 var subdomainRegexp = regexp.MustCompile("^[a-z0-9][a-z0-9-]+[a-z0-9]$")
@@ -29,25 +40,68 @@ type Client struct {
 	baseURL    *url.URL
 	httpClient *http.Client
 	credential Credential
+	auth       Authenticator
 	headers    map[string]string
+	logger     RequestLogger
+	observe    ObserveFunc
+
+	// apiPrefix replaces the path of baseURL when it's set by WithAPIPrefix
+	apiPrefix *string
+
+	// fieldOptions caches custom field options for ResolveCustomFieldValue.
+	// It's nil for clients which aren't created by NewClient, and they don't cache
+	fieldOptions *fieldOptionCache
+
+	// maxBodySize is the max size of a response body. Zero means no limit
+	maxBodySize int64
+
+	// dryRun captures write requests instead of sending them when it's not nil
+	dryRun *DryRunLog
 }
 
+// RequestLogger is a hook which is called after each API request with
+// the request, response, error and duration of the call.
+// The request passed to the hook doesn't contain the Authorization header
+type RequestLogger func(req *http.Request, resp *http.Response, err error, dur time.Duration)
+
 // NewClient creates new Zendesk API client
 func NewClient(httpClient *http.Client) (*Client, error) {
 	if httpClient == nil {
-		httpClient = http.DefaultClient
+		httpClient = &http.Client{Timeout: DefaultTimeout}
 	}
 
-	client := &Client{httpClient: httpClient}
+	client := &Client{httpClient: httpClient, fieldOptions: newFieldOptionCache()}
 	client.headers = defaultHeaders
 	return client, nil
 }
 
+// WithTimeout sets the timeout of each API request including reading the response body.
+// Zero means no timeout. The *http.Client passed to NewClient is not modified
+func (z *Client) WithTimeout(d time.Duration) {
+	httpClient := *z.httpClient
+	httpClient.Timeout = d
+	z.httpClient = &httpClient
+}
+
 // SetHeader saves HTTP header in client. It will be included all API request
 func (z *Client) SetHeader(key string, value string) {
 	z.headers[key] = value
 }
 
+// AsUser returns a copy of client which makes every request on behalf of the user
+// with the email, by sending X-On-Behalf-Of header. The original client is not modified
+//
+// ref: https://developer.zendesk.com/rest_api/docs/support/requests#creating-requests-on-behalf-of-end-users
+func (z *Client) AsUser(email string) *Client {
+	c := *z
+	c.headers = make(map[string]string, len(z.headers)+1)
+	for key, value := range z.headers {
+		c.headers[key] = value
+	}
+	c.headers["X-On-Behalf-Of"] = email
+	return &c
+}
+
 // SetSubdomain saves subdomain in client. It will be used
 // when call API
 func (z *Client) SetSubdomain(subdomain string) error {
@@ -77,33 +131,119 @@ func (z *Client) SetEndpointURL(newURL string) error {
 	return nil
 }
 
+// WithBaseURL overrides the base URL of API. e.g. https://acme.zendesk.com/api/v2
+// It can be used to target a sandbox or a mock server.
+// Accounts on any pod (including EU) are served from the subdomain host,
+// so SetSubdomain is enough for them.
+// The URL must be absolute with scheme and host
+func (z *Client) WithBaseURL(u string) error {
+	baseURL, err := url.Parse(u)
+	if err != nil {
+		return err
+	}
+	if baseURL.Scheme == "" || baseURL.Host == "" {
+		return fmt.Errorf("%s is invalid base URL", u)
+	}
+
+	baseURL.Path = strings.TrimSuffix(baseURL.Path, "/")
+	z.baseURL = baseURL
+	return nil
+}
+
+// WithAPIPrefix replaces the path prefix of every request such as "/api/v3",
+// which is DefaultAPIPrefix for a client with SetSubdomain.
+// The host of the base URL is kept, and the prefix stays even if the base URL is set again
+func (z *Client) WithAPIPrefix(prefix string) {
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix != "" && !strings.HasPrefix(prefix, "/") {
+		prefix = "/" + prefix
+	}
+	z.apiPrefix = &prefix
+}
+
 // SetCredential saves credential in client. It will be set
 // to request header when call API
 func (z *Client) SetCredential(cred Credential) {
 	z.credential = cred
+	z.auth = nil
 }
 
-// get get JSON data from API and returns its body as []bytes
-func (z *Client) get(ctx context.Context, path string) ([]byte, error) {
-	req, err := http.NewRequest(http.MethodGet, z.baseURL.String()+path, nil)
-	if err != nil {
-		return nil, err
+// SetAuthenticator saves authenticator in client. It replaces
+// the credential which is set by SetCredential
+func (z *Client) SetAuthenticator(auth Authenticator) {
+	z.auth = auth
+	z.credential = nil
+}
+
+// WithOAuthToken makes client authenticate with OAuth access token.
+// Requests are sent with "Authorization: Bearer <token>" header instead of Basic authentication
+func (z *Client) WithOAuthToken(token string) {
+	z.SetAuthenticator(NewOAuthTokenCredential(token))
+}
+
+// SetRequestLogger saves the hook which is called after each API request
+func (z *Client) SetRequestLogger(logger RequestLogger) {
+	z.logger = logger
+}
+
+// resolve returns the absolute URL of path
+func (z *Client) resolve(path string) string {
+	if z.apiPrefix == nil {
+		return z.baseURL.String() + path
 	}
 
-	req = z.prepareRequest(ctx, req)
+	u := *z.baseURL
+	u.Path = *z.apiPrefix
+	u.RawPath = ""
+	return u.String() + path
+}
 
-	resp, err := z.httpClient.Do(req)
-	if err != nil {
-		return nil, err
+// basePath returns the path prefix of requests
+func (z *Client) basePath() string {
+	if z.apiPrefix != nil {
+		return *z.apiPrefix
 	}
-	defer resp.Body.Close()
+	if z.baseURL != nil {
+		return z.baseURL.Path
+	}
+	return ""
+}
 
-	body, err := ioutil.ReadAll(resp.Body)
+// get get JSON data from API and returns its body as []bytes
+func (z *Client) get(ctx context.Context, path string, opts ...RequestOption) ([]byte, error) {
+	return z.send(ctx, http.MethodGet, path, nil, http.StatusOK, opts...)
+}
+
+// post send data to API and returns response body as []bytes
+func (z *Client) post(ctx context.Context, path string, data interface{}, opts ...RequestOption) ([]byte, error) {
+	return z.send(ctx, http.MethodPost, path, data, http.StatusCreated, opts...)
+}
+
+// put sends data to API and returns response body as []bytes
+func (z *Client) put(ctx context.Context, path string, data interface{}, opts ...RequestOption) ([]byte, error) {
+	return z.send(ctx, http.MethodPut, path, data, http.StatusOK, opts...)
+}
+
+// patch sends data to API to partially update a resource and returns response body as []bytes
+func (z *Client) patch(ctx context.Context, path string, data interface{}, opts ...RequestOption) ([]byte, error) {
+	return z.send(ctx, http.MethodPatch, path, data, http.StatusOK, opts...)
+}
+
+// delete sends data to API and returns an error if unsuccessful
+func (z *Client) delete(ctx context.Context, path string, opts ...RequestOption) error {
+	_, err := z.send(ctx, http.MethodDelete, path, nil, http.StatusNoContent, opts...)
+	return err
+}
+
+// send sends a request with data marshaled as JSON, and returns response body as []bytes
+// if the response has the expected status. A nil data sends no request body
+func (z *Client) send(ctx context.Context, method, path string, data interface{}, status int, opts ...RequestOption) ([]byte, error) {
+	body, resp, err := z.sendJSON(ctx, method, path, data, status, opts...)
 	if err != nil {
 		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
+	if resp.StatusCode != status {
 		return nil, Error{
 			body: body,
 			resp: resp,
@@ -112,113 +252,146 @@ func (z *Client) get(ctx context.Context, path string) ([]byte, error) {
 	return body, nil
 }
 
-// post send data to API and returns response body as []bytes
-func (z *Client) post(ctx context.Context, path string, data interface{}) ([]byte, error) {
-	bytes, err := json.Marshal(data)
-	if err != nil {
-		return nil, err
+// sendJSON sends a request with data marshaled as JSON, and returns response body with the response.
+// A nil data sends no request body. In dry-run mode a write is captured instead, and the response
+// is synthesized with dryRunStatus
+func (z *Client) sendJSON(ctx context.Context, method, path string, data interface{}, dryRunStatus int, opts ...RequestOption) ([]byte, *http.Response, error) {
+	var b []byte
+	var reqBody io.Reader
+	if data != nil {
+		var err error
+		b, err = json.Marshal(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		reqBody = bytes.NewReader(b)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, z.baseURL.String()+path, strings.NewReader(string(bytes)))
-	if err != nil {
-		return nil, err
+	if body, resp, ok := z.captureDryRun(method, path, b, dryRunStatus); ok {
+		return body, resp, nil
 	}
 
-	req = z.prepareRequest(ctx, req)
+	return z.roundTrip(ctx, method, path, reqBody, opts...)
+}
 
-	resp, err := z.httpClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
+// RawAPI an interface containing methods to call endpoints directly
+type RawAPI interface {
+	Do(ctx context.Context, method, path string, body interface{}, opts ...RequestOption) ([]byte, *http.Response, error)
+	DoInto(ctx context.Context, method, path string, body interface{}, v interface{}, opts ...RequestOption) (*http.Response, error)
+}
 
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
+// Do sends an authenticated request to an endpoint which this package doesn't model yet.
+// path is relative to the base URL such as "/tickets/1/tags.json", and body is marshaled
+// as JSON unless it's nil. It returns the raw response body with the response, whose
+// Body is already consumed. A response without 2xx status is returned as Error.
+// In dry-run mode a write is captured the same way as the typed methods
+func (z *Client) Do(ctx context.Context, method, path string, body interface{}, opts ...RequestOption) ([]byte, *http.Response, error) {
+	respBody, resp, err := z.sendJSON(ctx, method, path, body, dryRunStatusOf(method), opts...)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 
-	if resp.StatusCode != http.StatusCreated {
-		return nil, Error{
-			body: body,
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return respBody, resp, Error{
+			body: respBody,
 			resp: resp,
 		}
 	}
-
-	return body, nil
+	return respBody, resp, nil
 }
 
-// put sends data to API and returns response body as []bytes
-func (z *Client) put(ctx context.Context, path string, data interface{}) ([]byte, error) {
-	bytes, err := json.Marshal(data)
+// DoInto is Do which unmarshals the response body into v.
+// The response body is ignored if v is nil
+func (z *Client) DoInto(ctx context.Context, method, path string, body interface{}, v interface{}, opts ...RequestOption) (*http.Response, error) {
+	respBody, resp, err := z.Do(ctx, method, path, body, opts...)
 	if err != nil {
-		return nil, err
+		return resp, err
+	}
+
+	if v == nil || len(respBody) == 0 {
+		return resp, nil
 	}
+	return resp, json.Unmarshal(respBody, v)
+}
 
-	req, err := http.NewRequest(http.MethodPut, z.baseURL.String()+path, strings.NewReader(string(bytes)))
+// roundTrip sends a prepared request to the path and reads the whole response body
+func (z *Client) roundTrip(ctx context.Context, method, path string, reqBody io.Reader, opts ...RequestOption) ([]byte, *http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, z.resolve(path), reqBody)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	req = z.prepareRequest(ctx, req)
+	req = z.prepareRequest(ctx, req, opts...)
 
-	resp, err := z.httpClient.Do(req)
+	resp, err := z.do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-
 	defer resp.Body.Close()
+
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
+	return body, resp, nil
+}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, Error{
-			body: body,
-			resp: resp,
-		}
+// prepare request sets common request variables such as authn and user agent
+func (z *Client) prepareRequest(ctx context.Context, req *http.Request, opts ...RequestOption) *http.Request {
+	out := req.WithContext(ctx)
+	z.includeHeaders(out)
+	z.authenticate(out)
+
+	for _, opt := range opts {
+		opt(out)
 	}
 
-	return body, nil
+	return out
 }
 
-// delete sends data to API and returns an error if unsuccessful
-func (z *Client) delete(ctx context.Context, path string) error {
-	req, err := http.NewRequest(http.MethodDelete, z.baseURL.String()+path, nil)
-	if err != nil {
-		return err
+// authenticate sets authentication header with configured authenticator or credential
+func (z *Client) authenticate(req *http.Request) {
+	switch {
+	case z.auth != nil:
+		z.auth.Authenticate(req)
+	case z.credential != nil:
+		req.SetBasicAuth(z.credential.Email(), z.credential.Secret())
 	}
+}
 
-	req = z.prepareRequest(ctx, req)
+// do sends the request with http client and calls the request logger
+func (z *Client) do(req *http.Request) (*http.Response, error) {
+	if resp, ok := z.captureDryRunRequest(req); ok {
+		return resp, nil
+	}
 
+	start := time.Now()
 	resp, err := z.httpClient.Do(req)
-	if err != nil {
-		return err
-	}
+	dur := time.Since(start)
 
-	defer resp.Body.Close()
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return err
+	if err == nil {
+		err = decodeContentEncoding(resp)
 	}
 
-	if resp.StatusCode != http.StatusNoContent {
-		return Error{
-			body: body,
-			resp: resp,
-		}
+	if err == nil && z.maxBodySize > 0 {
+		resp.Body = &maxBytesReader{ReadCloser: resp.Body, n: z.maxBodySize}
 	}
 
-	return nil
-}
+	if z.logger != nil {
+		logged := req.Clone(req.Context())
+		logged.Header.Del("Authorization")
+		z.logger(logged, resp, err, dur)
+	}
 
-// prepare request sets common request variables such as authn and user agent
-func (z *Client) prepareRequest(ctx context.Context, req *http.Request) *http.Request {
-	out := req.WithContext(ctx)
-	z.includeHeaders(out)
-	out.SetBasicAuth(z.credential.Email(), z.credential.Secret())
+	if z.observe != nil {
+		status := 0
+		if resp != nil {
+			status = resp.StatusCode
+		}
+		z.observe(z.endpointLabel(req.URL.Path), status, dur)
+	}
 
-	return out
+	return resp, err
 }
 
 // includeHeaders set HTTP headers from client.headers to *http.Request
@@ -230,6 +403,12 @@ func (z *Client) includeHeaders(req *http.Request) {
 
 // addOptions build query string
 func addOptions(s string, opts interface{}) (string, error) {
+	if v, ok := opts.(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return s, err
+		}
+	}
+
 	u, err := url.Parse(s)
 	if err != nil {
 		return s, err
@@ -243,3 +422,12 @@ func addOptions(s string, opts interface{}) (string, error) {
 	u.RawQuery = qs.Encode()
 	return u.String(), nil
 }
+
+// joinIDs build comma separated list of IDs for query string
+func joinIDs(ids []int64) string {
+	idStrs := make([]string, len(ids))
+	for i, id := range ids {
+		idStrs[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(idStrs, ",")
+}
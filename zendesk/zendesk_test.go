@@ -1,6 +1,8 @@
 package zendesk
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -9,6 +11,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 ////////// Helper //////////
@@ -70,6 +73,31 @@ func TestSetHeader(t *testing.T) {
 	}
 }
 
+func TestAsUser(t *testing.T) {
+	var onBehalfOf []string
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		onBehalfOf = append(onBehalfOf, r.Header.Get("X-On-Behalf-Of"))
+		w.Write(readFixture(filepath.Join(http.MethodGet, "groups.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	asUser := client.AsUser("customer@example.com")
+	if _, _, err := asUser.GetGroups(ctx); err != nil {
+		t.Fatalf("Failed to get groups: %s", err)
+	}
+	if _, _, err := client.GetGroups(ctx); err != nil {
+		t.Fatalf("Failed to get groups: %s", err)
+	}
+
+	if onBehalfOf[0] != "customer@example.com" {
+		t.Fatalf(`X-On-Behalf-Of header expect "customer@example.com", but got "%s"`, onBehalfOf[0])
+	}
+	if onBehalfOf[1] != "" {
+		t.Fatalf(`X-On-Behalf-Of header must not be sent by the original client, but got "%s"`, onBehalfOf[1])
+	}
+}
+
 func TestSetSubdomainSuccess(t *testing.T) {
 	validSubdomain := "subdomain"
 
@@ -95,6 +123,131 @@ func TestSetEndpointURL(t *testing.T) {
 	}
 }
 
+func TestWithBaseURL(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/groups.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "groups.json")))
+	}))
+	defer mockAPI.Close()
+
+	client, _ := NewClient(nil)
+	if err := client.WithBaseURL(mockAPI.URL + "/api/v2/"); err != nil {
+		t.Fatalf("WithBaseURL should success: %s", err)
+	}
+
+	if _, _, err := client.GetGroups(ctx); err != nil {
+		t.Fatalf("Failed to send request to overridden host: %s", err)
+	}
+}
+
+func TestWithAPIPrefix(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/preview/groups.json" {
+			t.Fatalf("Unexpected path %s", r.URL.Path)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "groups.json")))
+	}))
+	defer mockAPI.Close()
+
+	client, _ := NewClient(nil)
+	client.WithAPIPrefix("api/preview/")
+	if err := client.WithBaseURL(mockAPI.URL + "/api/v2"); err != nil {
+		t.Fatalf("WithBaseURL should success: %s", err)
+	}
+
+	if _, _, err := client.GetGroups(ctx); err != nil {
+		t.Fatalf("Failed to send request with the api prefix: %s", err)
+	}
+
+	if err := client.SetSubdomain("acme"); err != nil {
+		t.Fatalf("SetSubdomain should success: %s", err)
+	}
+	expected := "https://acme.zendesk.com/api/preview/tickets.json"
+	if actual := client.resolve("/tickets.json"); actual != expected {
+		t.Fatalf("URL expect %s, but got %s", expected, actual)
+	}
+	if label := client.endpointLabel("/api/preview/tickets/1.json"); label != "/tickets/{id}" {
+		t.Fatalf("endpoint label expect /tickets/{id}, but got %s", label)
+	}
+}
+
+func TestWithBaseURLInvalid(t *testing.T) {
+	client, _ := NewClient(nil)
+	for _, u := range []string{"acme.zendesk.com/api/v2", "/api/v2", "http://[::1"} {
+		if err := client.WithBaseURL(u); err == nil {
+			t.Fatalf("WithBaseURL should fail with %s", u)
+		}
+	}
+}
+
+func TestSetSubdomainResolvesHost(t *testing.T) {
+	client, _ := NewClient(nil)
+	if err := client.SetSubdomain("acme"); err != nil {
+		t.Fatalf("SetSubdomain should success: %s", err)
+	}
+
+	expected := "https://acme.zendesk.com/api/v2/tickets.json"
+	if actual := client.resolve("/tickets.json"); actual != expected {
+		t.Fatalf("URL expect %s, but got %s", expected, actual)
+	}
+}
+
+func newSlowMockAPI(d time.Duration) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(d):
+		}
+		w.Write([]byte(`{"groups":[]}`))
+	}))
+}
+
+func TestNewClientDefaultTimeout(t *testing.T) {
+	client, _ := NewClient(nil)
+	if client.httpClient.Timeout != DefaultTimeout {
+		t.Fatalf("timeout expect %s, but got %s", DefaultTimeout, client.httpClient.Timeout)
+	}
+}
+
+func TestCancelContextAbortsRequest(t *testing.T) {
+	mockAPI := newSlowMockAPI(5 * time.Second)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	canceled, cancel := context.WithCancel(ctx)
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, _, err := client.GetGroups(canceled)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expect context canceled error, but got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("Request was not aborted promptly. It took %s", elapsed)
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	mockAPI := newSlowMockAPI(5 * time.Second)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.WithTimeout(50 * time.Millisecond)
+	if http.DefaultClient.Timeout != 0 {
+		t.Fatal("WithTimeout should not modify the given http.Client")
+	}
+
+	start := time.Now()
+	if _, _, err := client.GetGroups(ctx); err == nil {
+		t.Fatal("Did not get error when the request timed out")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("Request did not time out promptly. It took %s", elapsed)
+	}
+}
+
 func TestSetCredential(t *testing.T) {
 	client, _ := NewClient(nil)
 	cred := NewBasicAuthCredential("john.doe@example.com", "password")
@@ -108,6 +261,48 @@ func TestSetCredential(t *testing.T) {
 	}
 }
 
+func TestWithOAuthToken(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth := r.Header.Get("Authorization"); auth != "Bearer accesstoken" {
+			t.Fatalf(`Authorization header expect "Bearer accesstoken", but got "%s"`, auth)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "groups.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.WithOAuthToken("accesstoken")
+	if client.credential != nil {
+		t.Fatal("credential should be cleared by WithOAuthToken")
+	}
+
+	if _, err := client.get(ctx, "/groups.json"); err != nil {
+		t.Fatalf("Failed to send request: %s", err)
+	}
+}
+
+func TestSetCredentialReplacesOAuthToken(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		email, secret, ok := r.BasicAuth()
+		if !ok {
+			t.Fatalf("Request did not have Basic authentication header: %s", r.Header.Get("Authorization"))
+		}
+		if email != "john.doe@example.com/token" || secret != "apitoken" {
+			t.Fatalf("Basic authentication does not match. email=%s secret=%s", email, secret)
+		}
+		w.Write(readFixture(filepath.Join(http.MethodGet, "groups.json")))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	client.WithOAuthToken("accesstoken")
+	client.SetCredential(NewAPITokenCredential("john.doe@example.com", "apitoken"))
+
+	if _, err := client.get(ctx, "/groups.json"); err != nil {
+		t.Fatalf("Failed to send request: %s", err)
+	}
+}
+
 func TestGet(t *testing.T) {
 	mockAPI := newMockAPI(http.MethodGet, "groups.json")
 	client := newTestClient(mockAPI)
@@ -251,6 +446,40 @@ func TestDeleteFailure(t *testing.T) {
 	}
 }
 
+func TestSetRequestLogger(t *testing.T) {
+	mockAPI := newMockAPIWithStatus(http.MethodGet, "groups.json", http.StatusInternalServerError)
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	called := false
+	client.SetRequestLogger(func(req *http.Request, resp *http.Response, err error, dur time.Duration) {
+		called = true
+		if req.Method != http.MethodGet {
+			t.Fatalf("Logged request has wrong method %s", req.Method)
+		}
+		if req.URL.Path != "/groups.json" {
+			t.Fatalf("Logged request has wrong path %s", req.URL.Path)
+		}
+		if auth := req.Header.Get("Authorization"); auth != "" {
+			t.Fatalf("Logged request must not contain credential: %s", auth)
+		}
+		if err != nil {
+			t.Fatalf("Logged unexpected error: %s", err)
+		}
+		if resp.StatusCode != http.StatusInternalServerError {
+			t.Fatalf("Logged response has wrong status %d", resp.StatusCode)
+		}
+		if dur <= 0 {
+			t.Fatalf("Logged duration is not positive: %s", dur)
+		}
+	})
+
+	client.get(ctx, "/groups.json")
+	if !called {
+		t.Fatal("Request logger was not called")
+	}
+}
+
 func TestIncludeHeaders(t *testing.T) {
 	client, _ := NewClient(nil)
 	client.headers = map[string]string{
@@ -304,3 +533,68 @@ func TestAddOptions(t *testing.T) {
 		t.Fatalf("\nExpect:\t%s\nGot:\t%s", expected, u)
 	}
 }
+
+func TestDo(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/api/v2/tickets/2/tags.json" {
+			t.Fatalf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if user, pass, ok := r.BasicAuth(); !ok || user != "agent@example.com/token" || pass != "secret" {
+			t.Fatalf("request is not authenticated: %s %s", user, pass)
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		if expected := `{"tags":["urgent"]}`; string(body) != expected {
+			t.Fatalf("request body expect %s, but got %s", expected, string(body))
+		}
+		w.Write([]byte(`{"tags":["urgent","vip"]}`))
+	}))
+	defer mockAPI.Close()
+
+	client, _ := NewClient(nil)
+	client.SetEndpointURL(mockAPI.URL + "/api/v2")
+	client.SetCredential(NewAPITokenCredential("agent@example.com", "secret"))
+
+	body, resp, err := client.Do(ctx, http.MethodPut, "/tickets/2/tags.json", map[string][]string{"tags": {"urgent"}})
+	if err != nil {
+		t.Fatalf("Failed to send raw request: %s", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status expect 200, but got %d", resp.StatusCode)
+	}
+	if expected := `{"tags":["urgent","vip"]}`; string(body) != expected {
+		t.Fatalf("response body expect %s, but got %s", expected, string(body))
+	}
+}
+
+func TestDoInto(t *testing.T) {
+	mockAPI := newMockAPI(http.MethodGet, "ticket.json")
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	var result struct {
+		Ticket Ticket `json:"ticket"`
+	}
+	if _, err := client.DoInto(ctx, http.MethodGet, "/tickets/2.json", nil, &result); err != nil {
+		t.Fatalf("Failed to send raw request: %s", err)
+	}
+	if result.Ticket.ID != 2 {
+		t.Fatalf("ticket id expect 2, but got %d", result.Ticket.ID)
+	}
+}
+
+func TestDoError(t *testing.T) {
+	mockAPI := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"RecordNotFound"}`))
+	}))
+	client := newTestClient(mockAPI)
+	defer mockAPI.Close()
+
+	body, resp, err := client.Do(ctx, http.MethodGet, "/unknown.json", nil)
+	if zerr, ok := err.(Error); !ok || zerr.Status() != http.StatusNotFound {
+		t.Fatalf("expected a not found error, but got %v", err)
+	}
+	if resp == nil || string(body) != `{"error":"RecordNotFound"}` {
+		t.Fatalf("expected the raw error response, but got %s", string(body))
+	}
+}